@@ -0,0 +1,79 @@
+package gonsole
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Maximal length of base64 encoded clipboard text. Many terminals ignore longer OSC 52 sequences.
+const MAX_CLIPBOARD_PAYLOAD = 100000
+
+// Clipboard is a selection buffer accessed with OSC 52.
+type Clipboard byte
+
+const (
+	CLIPBOARD         Clipboard = 'c' // System clipboard
+	PRIMARY_SELECTION Clipboard = 'p' // Selection pasted with middle button on X11, most other systems have clipboard only
+)
+
+// ClipboardEvent is a reply to clipboard query.
+type ClipboardEvent struct {
+	Clipboard Clipboard
+	Text      string
+}
+
+func (ClipboardEvent) isEvent() {}
+
+// Get text to copy text to clipboard (OSC 52). Works over SSH, when terminal allows programs to set clipboard.
+// Returns error when encoded text is longer than MAX_CLIPBOARD_PAYLOAD.
+func SetClipboard(c Clipboard, text string) (string, error) {
+	payload := base64.StdEncoding.EncodeToString([]byte(text))
+	if len(payload) > MAX_CLIPBOARD_PAYLOAD {
+		return "", errors.New("Text is too large for clipboard")
+	}
+	return osc(fmt.Sprintf("52;%c;%s", byte(c), payload)), nil
+}
+
+// Get text to ask terminal for clipboard content. Terminal replies with ClipboardEvent,
+// usually only when reading clipboard is allowed in its settings.
+func QueryClipboard(c Clipboard) string {
+	return osc(fmt.Sprintf("52;%c;?", byte(c)))
+}
+
+// Ask terminal for clipboard content and wait for reply. Input must be in raw mode.
+// Returns ErrNoReply when terminal does not reply in timeout, zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) ReadClipboard(w io.Writer, c Clipboard, timeout time.Duration) (string, error) {
+	if _, err := io.WriteString(w, QueryClipboard(c)); err != nil {
+		return "", err
+	}
+	ev, err := d.waitEvent(timeout, func(ev Event) bool {
+		_, ok := ev.(ClipboardEvent)
+		return ok
+	})
+	if err != nil {
+		return "", err
+	}
+	return ev.(ClipboardEvent).Text, nil
+}
+
+// Parse reply to clipboard query without OSC 52 prefix: selection ; base64 text.
+func clipboardReply(body string) (ClipboardEvent, bool) {
+	i := strings.IndexByte(body, ';')
+	if i < 0 {
+		return ClipboardEvent{}, false
+	}
+	text, err := base64.StdEncoding.DecodeString(body[i+1:])
+	if err != nil {
+		return ClipboardEvent{}, false
+	}
+
+	ev := ClipboardEvent{Clipboard: CLIPBOARD, Text: string(text)}
+	if i > 0 {
+		ev.Clipboard = Clipboard(body[0])
+	}
+	return ev, true
+}
@@ -0,0 +1,158 @@
+package gonsole
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetClipboard(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       Clipboard
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{name: "clipboard", c: CLIPBOARD, text: "id-42", want: "\x1b]52;c;aWQtNDI=\x1b\\"},
+		{name: "primary", c: PRIMARY_SELECTION, text: "ж", want: "\x1b]52;p;0LY=\x1b\\"},
+		{name: "empty", c: CLIPBOARD, text: "", want: "\x1b]52;c;\x1b\\"},
+		{name: "limit", c: CLIPBOARD, text: strings.Repeat("a", MAX_CLIPBOARD_PAYLOAD/4*3), want: "\x1b]52;c;" + strings.Repeat("YWFh", MAX_CLIPBOARD_PAYLOAD/4) + "\x1b\\"},
+		{name: "too large", c: CLIPBOARD, text: strings.Repeat("a", MAX_CLIPBOARD_PAYLOAD/4*3+1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SetClipboard(tt.c, tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetClipboard() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SetClipboard() = %.60q, want %.60q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeClipboard(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+	}{
+		{name: "reply", in: "\x1b]52;c;aGVsbG8=\x1b\\", want: ClipboardEvent{Clipboard: CLIPBOARD, Text: "hello"}},
+		{name: "reply bell", in: "\x1b]52;p;aGk=\x07", want: ClipboardEvent{Clipboard: PRIMARY_SELECTION, Text: "hi"}},
+		{name: "no selection", in: "\x1b]52;;\x07", want: ClipboardEvent{Clipboard: CLIPBOARD}},
+		{name: "invalid base64", in: "\x1b]52;c;!\x07", want: UnknownEvent{Sequence: "\x1b]52;c;!\x07"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := decodeEvent([]byte(tt.in), true); got != tt.want {
+				t.Errorf("decodeEvent(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecoder_ReadClipboard(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	go w.Write([]byte("a\x1b]52;c;Y29weQ==\x1b\\b"))
+
+	var out bytes.Buffer
+	got, err := d.ReadClipboard(&out, CLIPBOARD, time.Second)
+	if err != nil {
+		t.Fatalf("Decoder.ReadClipboard() error = %v", err)
+	}
+	if got != "copy" {
+		t.Errorf("Decoder.ReadClipboard() = %q, want %q", got, "copy")
+	}
+	if out.String() != "\x1b]52;c;?\x1b\\" {
+		t.Errorf("Decoder.ReadClipboard() wrote %q, want %q", out.String(), "\x1b]52;c;?\x1b\\")
+	}
+
+	// Key read before reply is kept
+	for _, want := range []rune{'a', 'b'} {
+		ev, err := d.ReadEvent()
+		if err != nil || ev != (KeyEvent{Key: KEY_RUNE, Rune: want}) {
+			t.Errorf("ReadEvent() = %#v, %v, want %q", ev, err, want)
+		}
+	}
+}
+
+func TestDecoder_ReadClipboardTimeout(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+
+	if _, err := d.ReadClipboard(io.Discard, CLIPBOARD, 10*time.Millisecond); err != ErrNoReply {
+		t.Errorf("Decoder.ReadClipboard() error = %v, want %v", err, ErrNoReply)
+	}
+}
+
+func TestDecoder_ReadClipboardLarge(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	d.Timeout = 10 * time.Millisecond
+
+	text := strings.Repeat("large clipboard text\n", 2000)
+	reply := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\x07"
+	go func() {
+		// Slow and large reply is not split by timeout and sequence length limit
+		for i := 0; i < len(reply); i += 10000 {
+			end := i + 10000
+			if end > len(reply) {
+				end = len(reply)
+			}
+			w.Write([]byte(reply[i:end]))
+			time.Sleep(30 * time.Millisecond)
+		}
+		w.Write([]byte("q"))
+	}()
+
+	got, err := d.ReadClipboard(io.Discard, CLIPBOARD, time.Second)
+	if err != nil {
+		t.Fatalf("Decoder.ReadClipboard() error = %v", err)
+	}
+	if got != text {
+		t.Errorf("Decoder.ReadClipboard() = %.40q, want %.40q", got, text)
+	}
+	if ev, err := d.ReadEvent(); err != nil || ev != (KeyEvent{Key: KEY_RUNE, Rune: 'q'}) {
+		t.Errorf("ReadEvent() = %#.40v, %v, want %q", ev, err, 'q')
+	}
+}
+
+func TestDecoder_ReadClipboardTruncated(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+
+	go func() {
+		// Truncated reply does not hold following keys, after control key or after timeout
+		w.Write([]byte("\x1b]52;c;Y29w"))
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("\x03\x1b]52;c;Y29wab"))
+	}()
+
+	if _, err := d.ReadClipboard(io.Discard, CLIPBOARD, 20*time.Millisecond); err != ErrNoReply {
+		t.Errorf("Decoder.ReadClipboard() error = %v, want %v", err, ErrNoReply)
+	}
+	want := []Event{
+		UnknownEvent{Sequence: "\x1b]52;c;Y29w"},
+		KeyEvent{Key: KEY_RUNE, Rune: 'c', Modifiers: MOD_CTRL},
+		UnknownEvent{Sequence: "\x1b]52;c;Y29wab"},
+	}
+	for i, w := range want {
+		got, err := d.ReadEvent()
+		if err != nil {
+			t.Fatalf("ReadEvent() #%d error = %v", i, err)
+		}
+		if got != w {
+			t.Errorf("ReadEvent() #%d = %#v, want %#v", i, got, w)
+		}
+	}
+}
@@ -4,61 +4,61 @@ import (
 	"testing"
 )
 
-func Test_color_Foreground(t *testing.T) {
+func TestPaletteColor_Foreground(t *testing.T) {
 	tests := []struct {
 		name string
-		c    color
+		c    PaletteColor
 		want string
 	}{
-		{name: "0", c: color(0), want: "\x1b[38;5;0m"},
-		{name: "10", c: color(10), want: "\x1b[38;5;10m"},
-		{name: "100", c: color(100), want: "\x1b[38;5;100m"},
-		{name: "255", c: color(255), want: "\x1b[38;5;255m"},
+		{name: "0", c: PaletteColor(0), want: "\x1b[38;5;0m"},
+		{name: "10", c: PaletteColor(10), want: "\x1b[38;5;10m"},
+		{name: "100", c: PaletteColor(100), want: "\x1b[38;5;100m"},
+		{name: "255", c: PaletteColor(255), want: "\x1b[38;5;255m"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := tt.c.Foreground(); got != tt.want {
-				t.Errorf("color.Foreground() = %v, want %v", got, tt.want)
+				t.Errorf("PaletteColor.Foreground() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func Test_color_Background(t *testing.T) {
+func TestPaletteColor_Background(t *testing.T) {
 	tests := []struct {
 		name string
-		c    color
+		c    PaletteColor
 		want string
 	}{
-		{name: "0", c: color(0), want: "\x1b[48;5;0m"},
-		{name: "10", c: color(10), want: "\x1b[48;5;10m"},
-		{name: "100", c: color(100), want: "\x1b[48;5;100m"},
-		{name: "255", c: color(255), want: "\x1b[48;5;255m"},
+		{name: "0", c: PaletteColor(0), want: "\x1b[48;5;0m"},
+		{name: "10", c: PaletteColor(10), want: "\x1b[48;5;10m"},
+		{name: "100", c: PaletteColor(100), want: "\x1b[48;5;100m"},
+		{name: "255", c: PaletteColor(255), want: "\x1b[48;5;255m"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := tt.c.Background(); got != tt.want {
-				t.Errorf("color.Background() = %v, want %v", got, tt.want)
+				t.Errorf("PaletteColor.Background() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func Test_color_Underline(t *testing.T) {
+func TestPaletteColor_Underline(t *testing.T) {
 	tests := []struct {
 		name string
-		c    color
+		c    PaletteColor
 		want string
 	}{
-		{name: "0", c: color(0), want: "\x1b[58;5;0m"},
-		{name: "10", c: color(10), want: "\x1b[58;5;10m"},
-		{name: "100", c: color(100), want: "\x1b[58;5;100m"},
-		{name: "255", c: color(255), want: "\x1b[58;5;255m"},
+		{name: "0", c: PaletteColor(0), want: "\x1b[58;5;0m"},
+		{name: "10", c: PaletteColor(10), want: "\x1b[58;5;10m"},
+		{name: "100", c: PaletteColor(100), want: "\x1b[58;5;100m"},
+		{name: "255", c: PaletteColor(255), want: "\x1b[58;5;255m"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := tt.c.Underline(); got != tt.want {
-				t.Errorf("color.Underline() = %v, want %v", got, tt.want)
+				t.Errorf("PaletteColor.Underline() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -66,17 +66,17 @@ func Test_color_Underline(t *testing.T) {
 
 func TestForeground(t *testing.T) {
 	type args struct {
-		c color
+		c Color
 	}
 	tests := []struct {
 		name string
 		args args
 		want string
 	}{
-		{name: "0", args: args{c: color(0)}, want: "\x1b[38;5;0m"},
-		{name: "10", args: args{c: color(10)}, want: "\x1b[38;5;10m"},
-		{name: "100", args: args{c: color(100)}, want: "\x1b[38;5;100m"},
-		{name: "255", args: args{c: color(255)}, want: "\x1b[38;5;255m"},
+		{name: "0", args: args{c: PaletteColor(0)}, want: "\x1b[38;5;0m"},
+		{name: "10", args: args{c: PaletteColor(10)}, want: "\x1b[38;5;10m"},
+		{name: "100", args: args{c: PaletteColor(100)}, want: "\x1b[38;5;100m"},
+		{name: "255", args: args{c: PaletteColor(255)}, want: "\x1b[38;5;255m"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -89,17 +89,17 @@ func TestForeground(t *testing.T) {
 
 func TestBackground(t *testing.T) {
 	type args struct {
-		c color
+		c Color
 	}
 	tests := []struct {
 		name string
 		args args
 		want string
 	}{
-		{name: "0", args: args{c: color(0)}, want: "\x1b[48;5;0m"},
-		{name: "10", args: args{c: color(10)}, want: "\x1b[48;5;10m"},
-		{name: "100", args: args{c: color(100)}, want: "\x1b[48;5;100m"},
-		{name: "255", args: args{c: color(255)}, want: "\x1b[48;5;255m"},
+		{name: "0", args: args{c: PaletteColor(0)}, want: "\x1b[48;5;0m"},
+		{name: "10", args: args{c: PaletteColor(10)}, want: "\x1b[48;5;10m"},
+		{name: "100", args: args{c: PaletteColor(100)}, want: "\x1b[48;5;100m"},
+		{name: "255", args: args{c: PaletteColor(255)}, want: "\x1b[48;5;255m"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -112,17 +112,17 @@ func TestBackground(t *testing.T) {
 
 func TestUnderline(t *testing.T) {
 	type args struct {
-		c color
+		c Color
 	}
 	tests := []struct {
 		name string
 		args args
 		want string
 	}{
-		{name: "0", args: args{c: color(0)}, want: "\x1b[58;5;0m"},
-		{name: "10", args: args{c: color(10)}, want: "\x1b[58;5;10m"},
-		{name: "100", args: args{c: color(100)}, want: "\x1b[58;5;100m"},
-		{name: "255", args: args{c: color(255)}, want: "\x1b[58;5;255m"},
+		{name: "0", args: args{c: PaletteColor(0)}, want: "\x1b[58;5;0m"},
+		{name: "10", args: args{c: PaletteColor(10)}, want: "\x1b[58;5;10m"},
+		{name: "100", args: args{c: PaletteColor(100)}, want: "\x1b[58;5;100m"},
+		{name: "255", args: args{c: PaletteColor(255)}, want: "\x1b[58;5;255m"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,158 @@
+package gonsole
+
+import (
+	"math"
+)
+
+// CIELAB values of every color in 256 colors palette, used for nearest color search.
+var paletteLab = func() (result [256]lab) {
+	for i := range result {
+		result[i] = paletteTable[i].rgb.lab()
+	}
+	return result
+}()
+
+// Color in CIELAB color space.
+type lab struct {
+	l float64
+	a float64
+	b float64
+}
+
+// Get nearest color from 256 colors palette. Only 6x6x6 color cube and grayscale ramp (16-255) are used,
+// because first 16 colors are usually redefined by terminal theme.
+func (c RGB) Nearest256() PaletteColor {
+	return c.nearest(16, 256)
+}
+
+// Get nearest of 16 standard ANSI colors (COLOR_BLACK - COLOR_WHITE), matching STD_COLOR_* sequences.
+func (c RGB) Nearest16() PaletteColor {
+	return c.nearest(0, 16)
+}
+
+// Get nearest of 8 basic ANSI colors (COLOR_BLACK - COLOR_SILVER), matching STD_COLOR_* non bright sequences.
+func (c RGB) Nearest8() PaletteColor {
+	return c.nearest(0, 8)
+}
+
+// Get color with minimal CIEDE2000 distance in palette range [from, to).
+func (c RGB) nearest(from, to int) PaletteColor {
+	target := c.lab()
+	best, bestDistance := from, math.Inf(1)
+	for i := from; i < to; i++ {
+		if d := deltaE2000(target, paletteLab[i]); d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return PaletteColor(best)
+}
+
+// Get nearest of 16 standard ANSI colors.
+func (c PaletteColor) Nearest16() PaletteColor {
+	if c >= 0 && c < 16 {
+		return c
+	}
+	return c.RGB().Nearest16()
+}
+
+// Get nearest of 8 basic ANSI colors.
+func (c PaletteColor) Nearest8() PaletteColor {
+	if c >= 0 && c < 8 {
+		return c
+	}
+	return c.RGB().Nearest8()
+}
+
+// Convert sRGB color to CIELAB with D65 white point.
+func (c RGB) lab() lab {
+	r, g, b := linearChannel(c.R), linearChannel(c.G), linearChannel(c.B)
+
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*b) / 0.95047
+	y := 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*b) / 1.08883
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389.0 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27.0*t + 16) / 116
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+
+	return lab{l: 116*fy - 16, a: 500 * (fx - fy), b: 200 * (fy - fz)}
+}
+
+// Get linear light intensity of sRGB channel, from 0 to 1.
+func linearChannel(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+// Get CIEDE2000 color difference between two CIELAB colors.
+func deltaE2000(c1, c2 lab) float64 {
+	const deg = math.Pi / 180
+
+	cab1 := math.Hypot(c1.a, c1.b)
+	cab2 := math.Hypot(c2.a, c2.b)
+	cabMean7 := math.Pow((cab1+cab2)/2, 7)
+	g := 0.5 * (1 - math.Sqrt(cabMean7/(cabMean7+math.Pow(25, 7))))
+
+	a1, a2 := (1+g)*c1.a, (1+g)*c2.a
+	cp1, cp2 := math.Hypot(a1, c1.b), math.Hypot(a2, c2.b)
+
+	hue := func(b, a float64) float64 {
+		if a == 0 && b == 0 {
+			return 0
+		}
+		h := math.Atan2(b, a) / deg
+		if h < 0 {
+			h += 360
+		}
+		return h
+	}
+	hp1, hp2 := hue(c1.b, a1), hue(c2.b, a2)
+
+	dL := c2.l - c1.l
+	dC := cp2 - cp1
+	dh := 0.0
+	if cp1*cp2 != 0 {
+		dh = hp2 - hp1
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(cp1*cp2) * math.Sin(dh/2*deg)
+
+	lMean := (c1.l + c2.l) / 2
+	cMean := (cp1 + cp2) / 2
+	hMean := hp1 + hp2
+	if cp1*cp2 != 0 {
+		if math.Abs(hp1-hp2) > 180 {
+			if hMean < 360 {
+				hMean += 360
+			} else {
+				hMean -= 360
+			}
+		}
+		hMean /= 2
+	}
+
+	t := 1 - 0.17*math.Cos((hMean-30)*deg) + 0.24*math.Cos(2*hMean*deg) +
+		0.32*math.Cos((3*hMean+6)*deg) - 0.20*math.Cos((4*hMean-63)*deg)
+	dTheta := 30 * math.Exp(-math.Pow((hMean-275)/25, 2))
+	cMean7 := math.Pow(cMean, 7)
+	rC := 2 * math.Sqrt(cMean7/(cMean7+math.Pow(25, 7)))
+	lMean50 := (lMean - 50) * (lMean - 50)
+	sL := 1 + 0.015*lMean50/math.Sqrt(20+lMean50)
+	sC := 1 + 0.045*cMean
+	sH := 1 + 0.015*cMean*t
+	rT := -math.Sin(2*dTheta*deg) * rC
+
+	l, c, h := dL/sL, dC/sC, dH/sH
+	return math.Sqrt(l*l + c*c + h*h + rT*c*h)
+}
@@ -0,0 +1,111 @@
+package gonsole
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRGB_Nearest256(t *testing.T) {
+	tests := []struct {
+		name string
+		c    RGB
+		want PaletteColor
+	}{
+		{name: "exact cube", c: RGB{R: 0x5F, G: 0x87, B: 0xFF}, want: COLOR_CORNFLOWER_BLUE},
+		{name: "near cube", c: RGB{R: 0x60, G: 0x88, B: 0xFA}, want: COLOR_CORNFLOWER_BLUE},
+		{name: "gray ramp", c: RGB{R: 0x80, G: 0x80, B: 0x80}, want: 244},
+		{name: "black", c: RGB{}, want: 16},
+		{name: "white", c: RGB{R: 255, G: 255, B: 255}, want: 231},
+		{name: "red", c: RGB{R: 250, G: 5, B: 5}, want: 196},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Nearest256(); got != tt.want {
+				t.Errorf("RGB.Nearest256() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGB_Nearest16(t *testing.T) {
+	tests := []struct {
+		name string
+		c    RGB
+		want PaletteColor
+	}{
+		{name: "black", c: RGB{R: 10, G: 10, B: 10}, want: COLOR_BLACK},
+		{name: "bright red", c: RGB{R: 240, G: 20, B: 20}, want: COLOR_RED},
+		{name: "dark red", c: RGB{R: 120, G: 10, B: 5}, want: COLOR_MAROON},
+		{name: "light gray", c: RGB{R: 190, G: 190, B: 190}, want: COLOR_SILVER},
+		{name: "orange", c: RGB{R: 255, G: 220, B: 0}, want: COLOR_YELLOW},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Nearest16(); got != tt.want {
+				t.Errorf("RGB.Nearest16() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGB_Nearest8(t *testing.T) {
+	tests := []struct {
+		name string
+		c    RGB
+		want PaletteColor
+	}{
+		{name: "black", c: RGB{R: 10, G: 10, B: 10}, want: COLOR_BLACK},
+		{name: "red", c: RGB{R: 240, G: 20, B: 20}, want: COLOR_MAROON},
+		{name: "white", c: RGB{R: 255, G: 255, B: 255}, want: COLOR_SILVER},
+		{name: "blue", c: RGB{R: 0, G: 0, B: 200}, want: COLOR_NAVY_BLUE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Nearest8(); got != tt.want {
+				t.Errorf("RGB.Nearest8() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaletteColor_Nearest16(t *testing.T) {
+	tests := []struct {
+		name string
+		c    PaletteColor
+		want PaletteColor
+	}{
+		{name: "standard", c: COLOR_TEAL, want: COLOR_TEAL},
+		{name: "cube red", c: 196, want: COLOR_RED},
+		{name: "cube duplicate white", c: 231, want: COLOR_WHITE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Nearest16(); got != tt.want {
+				t.Errorf("PaletteColor.Nearest16() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_deltaE2000(t *testing.T) {
+	// Test data from Sharma, Wu, Dalal "The CIEDE2000 Color-Difference Formula".
+	tests := []struct {
+		name string
+		c1   lab
+		c2   lab
+		want float64
+	}{
+		{name: "pair 1", c1: lab{50, 2.6772, -79.7751}, c2: lab{50, 0, -82.7485}, want: 2.0425},
+		{name: "pair 7", c1: lab{50, 0, 0}, c2: lab{50, -1, 2}, want: 2.3669},
+		{name: "pair 17", c1: lab{50, 2.5, 0}, c2: lab{73, 25, -18}, want: 27.1492},
+		{name: "pair 25", c1: lab{60.2574, -34.0099, 36.2677}, c2: lab{60.4626, -34.1751, 39.4387}, want: 1.2644},
+		{name: "equal", c1: lab{50, 10, 10}, c2: lab{50, 10, 10}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deltaE2000(tt.c1, tt.c2); math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("deltaE2000() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
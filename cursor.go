@@ -0,0 +1,189 @@
+package gonsole
+
+import (
+	"fmt"
+	"io"
+)
+
+const (
+	SAVE_CURSOR        = "\x1b7"     // DECSC, saves position, attributes and character set
+	RESTORE_CURSOR     = "\x1b8"     // DECRC, restores state saved with SAVE_CURSOR
+	SAVE_CURSOR_SCO    = "\x1b[s"    // SCO form, saves only position. Conflicts with left and right margins mode in xterm
+	RESTORE_CURSOR_SCO = "\x1b[u"    // SCO form, restores position saved with SAVE_CURSOR_SCO
+	HIDE_CURSOR        = "\x1b[?25l" // DECTCEM off
+	SHOW_CURSOR        = "\x1b[?25h" // DECTCEM on
+)
+
+// CursorShape is a cursor style set with DECSCUSR.
+type CursorShape int
+
+const (
+	CURSOR_SHAPE_DEFAULT        CursorShape = iota // Shape configured by user
+	CURSOR_SHAPE_BLINKING_BLOCK                    // Default of most terminals
+	CURSOR_SHAPE_STEADY_BLOCK
+	CURSOR_SHAPE_BLINKING_UNDERLINE
+	CURSOR_SHAPE_STEADY_UNDERLINE
+	CURSOR_SHAPE_BLINKING_BAR // xterm extension, widely supported
+	CURSOR_SHAPE_STEADY_BAR   // xterm extension, widely supported
+)
+
+// Get text to move cursor up by n lines. Cursor stops at the top of screen.
+func CursorUp(n int) string {
+	return cursorMove(n, 'A')
+}
+
+// Get text to move cursor down by n lines. Cursor stops at the bottom of screen.
+func CursorDown(n int) string {
+	return cursorMove(n, 'B')
+}
+
+// Get text to move cursor forward (right) by n columns.
+func CursorForward(n int) string {
+	return cursorMove(n, 'C')
+}
+
+// Get text to move cursor back (left) by n columns.
+func CursorBack(n int) string {
+	return cursorMove(n, 'D')
+}
+
+// Get text to move cursor to the beginning of line n lines down.
+func CursorNextLine(n int) string {
+	return cursorMove(n, 'E')
+}
+
+// Get text to move cursor to the beginning of line n lines up.
+func CursorPreviousLine(n int) string {
+	return cursorMove(n, 'F')
+}
+
+// Get text to move cursor to column in current line. Columns start from 1.
+func CursorColumn(column int) string {
+	return fmt.Sprintf("\x1b[%dG", atLeastOne(column))
+}
+
+// Get text to move cursor to row and column (CUP). Rows and columns start from 1.
+func CursorPosition(row, column int) string {
+	return fmt.Sprintf("\x1b[%d;%dH", atLeastOne(row), atLeastOne(column))
+}
+
+// Get text to set cursor shape (DECSCUSR).
+func (s CursorShape) Sequence() string {
+	return fmt.Sprintf("\x1b[%d q", int(s))
+}
+
+// Get text to set cursor shape (DECSCUSR).
+func SetCursorShape(s CursorShape) string {
+	return s.Sequence()
+}
+
+// Get text to move cursor by n in direction of final byte. Moving by less than 1 gives empty string,
+// because terminals treat 0 as 1.
+func cursorMove(n int, final byte) string {
+	if n < 1 {
+		return ""
+	}
+	return fmt.Sprintf("\x1b[%d%c", n, final)
+}
+
+// Get value, but not less than 1.
+func atLeastOne(v int) int {
+	if v < 1 {
+		return 1
+	}
+	return v
+}
+
+// Cursor writes cursor control sequences to writer, usually os.Stdout.
+type Cursor struct {
+	w io.Writer
+}
+
+// Create cursor controlling terminal behind writer.
+func NewCursor(w io.Writer) Cursor {
+	return Cursor{w: w}
+}
+
+// Move cursor up by n lines.
+func (c Cursor) Up(n int) error {
+	return c.write(CursorUp(n))
+}
+
+// Move cursor down by n lines.
+func (c Cursor) Down(n int) error {
+	return c.write(CursorDown(n))
+}
+
+// Move cursor forward (right) by n columns.
+func (c Cursor) Forward(n int) error {
+	return c.write(CursorForward(n))
+}
+
+// Move cursor back (left) by n columns.
+func (c Cursor) Back(n int) error {
+	return c.write(CursorBack(n))
+}
+
+// Move cursor to the beginning of line n lines down.
+func (c Cursor) NextLine(n int) error {
+	return c.write(CursorNextLine(n))
+}
+
+// Move cursor to the beginning of line n lines up.
+func (c Cursor) PreviousLine(n int) error {
+	return c.write(CursorPreviousLine(n))
+}
+
+// Move cursor to column in current line. Columns start from 1.
+func (c Cursor) Column(column int) error {
+	return c.write(CursorColumn(column))
+}
+
+// Move cursor to row and column. Rows and columns start from 1.
+func (c Cursor) Position(row, column int) error {
+	return c.write(CursorPosition(row, column))
+}
+
+// Save cursor position and attributes (DECSC).
+func (c Cursor) Save() error {
+	return c.write(SAVE_CURSOR)
+}
+
+// Restore cursor position and attributes saved with Save (DECRC).
+func (c Cursor) Restore() error {
+	return c.write(RESTORE_CURSOR)
+}
+
+// Save cursor position in SCO form.
+func (c Cursor) SaveSCO() error {
+	return c.write(SAVE_CURSOR_SCO)
+}
+
+// Restore cursor position saved with SaveSCO.
+func (c Cursor) RestoreSCO() error {
+	return c.write(RESTORE_CURSOR_SCO)
+}
+
+// Hide cursor.
+func (c Cursor) Hide() error {
+	return c.write(HIDE_CURSOR)
+}
+
+// Show cursor.
+func (c Cursor) Show() error {
+	return c.write(SHOW_CURSOR)
+}
+
+// Set cursor shape.
+func (c Cursor) Shape(s CursorShape) error {
+	return c.write(s.Sequence())
+}
+
+// Write sequence to writer.
+func (c Cursor) write(seq string) error {
+	if seq == "" {
+		return nil
+	}
+	_, err := io.WriteString(c.w, seq)
+	return err
+}
@@ -0,0 +1,81 @@
+package gonsole
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCursorMove(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "up", got: CursorUp(3), want: "\x1b[3A"},
+		{name: "down", got: CursorDown(1), want: "\x1b[1B"},
+		{name: "forward", got: CursorForward(10), want: "\x1b[10C"},
+		{name: "back", got: CursorBack(2), want: "\x1b[2D"},
+		{name: "next line", got: CursorNextLine(4), want: "\x1b[4E"},
+		{name: "previous line", got: CursorPreviousLine(5), want: "\x1b[5F"},
+		{name: "zero", got: CursorUp(0), want: ""},
+		{name: "negative", got: CursorBack(-1), want: ""},
+		{name: "column", got: CursorColumn(7), want: "\x1b[7G"},
+		{name: "column clamp", got: CursorColumn(0), want: "\x1b[1G"},
+		{name: "position", got: CursorPosition(3, 14), want: "\x1b[3;14H"},
+		{name: "position clamp", got: CursorPosition(-2, 0), want: "\x1b[1;1H"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetCursorShape(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape CursorShape
+		want  string
+	}{
+		{name: "default", shape: CURSOR_SHAPE_DEFAULT, want: "\x1b[0 q"},
+		{name: "blinking block", shape: CURSOR_SHAPE_BLINKING_BLOCK, want: "\x1b[1 q"},
+		{name: "steady underline", shape: CURSOR_SHAPE_STEADY_UNDERLINE, want: "\x1b[4 q"},
+		{name: "steady bar", shape: CURSOR_SHAPE_STEADY_BAR, want: "\x1b[6 q"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetCursorShape(tt.shape); got != tt.want {
+				t.Errorf("SetCursorShape() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	var out bytes.Buffer
+	c := NewCursor(&out)
+	steps := []func() error{
+		c.Hide,
+		c.Save,
+		func() error { return c.Position(2, 3) },
+		func() error { return c.Up(0) },
+		func() error { return c.Forward(4) },
+		c.Restore,
+		c.SaveSCO,
+		c.RestoreSCO,
+		func() error { return c.Shape(CURSOR_SHAPE_BLINKING_BAR) },
+		c.Show,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("Cursor error = %v", err)
+		}
+	}
+
+	want := "\x1b[?25l\x1b7\x1b[2;3H\x1b[4C\x1b8\x1b[s\x1b[u\x1b[5 q\x1b[?25h"
+	if got := out.String(); got != want {
+		t.Errorf("Cursor wrote %q, want %q", got, want)
+	}
+}
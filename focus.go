@@ -0,0 +1,13 @@
+package gonsole
+
+const (
+	ENABLE_FOCUS_REPORTING  = "\x1b[?1004h" // Terminal reports focus changes as FocusEvent
+	DISABLE_FOCUS_REPORTING = "\x1b[?1004l"
+)
+
+// FocusEvent is reported when terminal window gains or loses focus.
+type FocusEvent struct {
+	Focused bool
+}
+
+func (FocusEvent) isEvent() {}
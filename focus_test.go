@@ -0,0 +1,24 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestDecodeFocus(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+	}{
+		{name: "focus in", in: "\x1b[I", want: FocusEvent{Focused: true}},
+		{name: "focus out", in: "\x1b[O", want: FocusEvent{Focused: false}},
+		{name: "with parameter", in: "\x1b[1I", want: UnknownEvent{Sequence: "\x1b[1I"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := decodeEvent([]byte(tt.in), true); got != tt.want {
+				t.Errorf("decodeEvent(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package gonsole
+
+// Character cell of terminal screen. Wide character takes cell with width 2 followed by empty cell with width 0.
+type gridCell struct {
+	text  string
+	width int
+	state SGRState
+}
+
+// Lay out styled text into lines of terminal cells, as terminal prints it.
+// Lines longer than columns are wrapped, 0 columns means no wrapping. Tabs move to the next multiple of 8,
+// carriage return moves to the start of line. Escape sequences other than SGR are ignored.
+func layoutGrid(s string, columns int) [][]gridCell {
+	lines := [][]gridCell{nil}
+	var state SGRState
+	column := 0
+
+	put := func(c gridCell) {
+		if columns > 0 && column+c.width > columns {
+			lines = append(lines, nil)
+			column = 0
+		}
+		line := lines[len(lines)-1]
+		for len(line) < column+c.width {
+			line = append(line, gridCell{text: " ", width: 1})
+		}
+
+		// Overwritten halves of wide characters become spaces
+		if line[column].width == 0 && column > 0 {
+			line[column-1] = gridCell{text: " ", width: 1, state: line[column-1].state}
+		}
+		if last := column + c.width - 1; line[last].width == 2 && last+1 < len(line) {
+			line[last+1] = gridCell{text: " ", width: 1, state: line[last].state}
+		}
+
+		line[column] = c
+		if c.width == 2 {
+			line[column+1] = gridCell{state: c.state}
+		}
+		lines[len(lines)-1] = line
+		column += c.width
+	}
+
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case 0x1b:
+			n := escapeLength(s[i:])
+			trackSGR(&state, s[i:i+n])
+			i += n
+			continue
+		case '\n':
+			lines = append(lines, nil)
+			column = 0
+			i++
+			continue
+		case '\r':
+			column = 0
+			i++
+			continue
+		case '\t':
+			for next := (column/8 + 1) * 8; column < next && (columns == 0 || column < columns); {
+				put(gridCell{text: " ", width: 1, state: state})
+			}
+			i++
+			continue
+		}
+
+		size, width := nextCluster(s[i:])
+		text := s[i : i+size]
+		i += size
+		if width == 0 {
+			if line := lines[len(lines)-1]; column > 0 && column <= len(line) && text[0] >= 0x20 {
+				for j := column - 1; j >= 0; j-- {
+					if line[j].width > 0 {
+						line[j].text += text
+						break
+					}
+				}
+			}
+			continue
+		}
+		put(gridCell{text: text, width: width, state: state})
+	}
+
+	return lines
+}
+
+// Get number of columns of the widest line.
+func gridWidth(lines [][]gridCell) int {
+	result := 0
+	for _, line := range lines {
+		if len(line) > result {
+			result = len(line)
+		}
+	}
+	return result
+}
@@ -0,0 +1,186 @@
+package gonsole
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// HTMLOptions configures conversion of styled text to HTML.
+type HTMLOptions struct {
+	Classes     bool   // Use CSS classes from HTMLOptions.Stylesheet for attributes and palette colors instead of inline styles
+	ClassPrefix string // Prefix of CSS classes, "gonsole-" when empty
+	Foreground  Color  // Default foreground color used for inverted text, COLOR_SILVER when nil
+	Background  Color  // Default background color used for inverted text, COLOR_BLACK when nil
+}
+
+// CSS values of underline kinds.
+var htmlUnderlineStyles = map[UnderlineStyle]string{
+	UNDERLINE_DOUBLE: "double",
+	UNDERLINE_CURLY:  "wavy",
+	UNDERLINE_DOTTED: "dotted",
+	UNDERLINE_DASHED: "dashed",
+}
+
+// Get HTML with inline styles from text with SGR sequences. Result keeps line breaks, so it should be placed into <pre>.
+func HTML(s string) string {
+	return HTMLOptions{}.Render(s)
+}
+
+// Get HTML from text with SGR sequences. Result keeps line breaks, so it should be placed into <pre>.
+func (o HTMLOptions) Render(s string) string {
+	var result strings.Builder
+	for _, segment := range styledSegments(s) {
+		text := html.EscapeString(segment.text)
+		classes, styles := o.attributes(segment.state)
+		if len(classes) == 0 && len(styles) == 0 {
+			result.WriteString(text)
+			continue
+		}
+
+		result.WriteString("<span")
+		if len(classes) > 0 {
+			result.WriteString(` class="` + strings.Join(classes, " ") + `"`)
+		}
+		if len(styles) > 0 {
+			result.WriteString(` style="` + strings.Join(styles, ";") + `"`)
+		}
+		result.WriteString(">" + text + "</span>")
+	}
+	return result.String()
+}
+
+// Get CSS stylesheet with classes used by HTMLOptions.Render with Classes option.
+// Palette classes use the same values as COLOR_* constants.
+func (o HTMLOptions) Stylesheet() string {
+	p := "." + o.prefix()
+
+	var result strings.Builder
+	rules := []string{
+		p + "bold{font-weight:bold}",
+		p + "faint{opacity:0.5}",
+		p + "italic{font-style:italic}",
+		p + "hidden{visibility:hidden}",
+		p + "underline{text-decoration-line:underline}",
+		p + "strike{text-decoration-line:line-through}",
+		p + "overline{text-decoration-line:overline}",
+		p + "underline" + p + "strike{text-decoration-line:underline line-through}",
+		p + "underline" + p + "overline{text-decoration-line:underline overline}",
+		p + "strike" + p + "overline{text-decoration-line:line-through overline}",
+		p + "underline" + p + "strike" + p + "overline{text-decoration-line:underline line-through overline}",
+	}
+	for _, kind := range []UnderlineStyle{UNDERLINE_DOUBLE, UNDERLINE_CURLY, UNDERLINE_DOTTED, UNDERLINE_DASHED} {
+		rules = append(rules, fmt.Sprintf("%sunderline-%s{text-decoration-style:%s}", p, htmlUnderlineStyles[kind], htmlUnderlineStyles[kind]))
+	}
+	for _, rule := range rules {
+		result.WriteString(rule + "\n")
+	}
+
+	for i, entry := range paletteTable {
+		hex := entry.rgb.Hex()
+		fmt.Fprintf(&result, "%sfg-%d{color:%s}\n", p, i, hex)
+		fmt.Fprintf(&result, "%sbg-%d{background-color:%s}\n", p, i, hex)
+		fmt.Fprintf(&result, "%sul-%d{text-decoration-color:%s}\n", p, i, hex)
+	}
+	return result.String()
+}
+
+// Get CSS classes and inline styles for attributes.
+func (o HTMLOptions) attributes(state SGRState) ([]string, []string) {
+	var classes, styles []string
+	p := o.prefix()
+	add := func(class, style string) {
+		if o.Classes {
+			classes = append(classes, p+class)
+		} else {
+			styles = append(styles, style)
+		}
+	}
+
+	if state.Bold {
+		add("bold", "font-weight:bold")
+	}
+	if state.Faint {
+		add("faint", "opacity:0.5")
+	}
+	if state.Italic {
+		add("italic", "font-style:italic")
+	}
+	if state.Hidden {
+		add("hidden", "visibility:hidden")
+	}
+
+	var lines []string
+	if state.Underline != UNDERLINE_NONE {
+		lines = append(lines, "underline")
+		if o.Classes {
+			classes = append(classes, p+"underline")
+		}
+	}
+	if state.Crossed {
+		lines = append(lines, "line-through")
+		if o.Classes {
+			classes = append(classes, p+"strike")
+		}
+	}
+	if state.Overlined {
+		lines = append(lines, "overline")
+		if o.Classes {
+			classes = append(classes, p+"overline")
+		}
+	}
+	if len(lines) > 0 && !o.Classes {
+		styles = append(styles, "text-decoration-line:"+strings.Join(lines, " "))
+	}
+	if kind, ok := htmlUnderlineStyles[state.Underline]; ok {
+		add("underline-"+kind, "text-decoration-style:"+kind)
+	}
+
+	fg, bg := state.colors(o.defaultForeground(), o.defaultBackground())
+	o.color(&classes, &styles, fg, "fg", "color")
+	o.color(&classes, &styles, bg, "bg", "background-color")
+	if state.Underline != UNDERLINE_NONE {
+		o.color(&classes, &styles, state.UnderlineColor, "ul", "text-decoration-color")
+	}
+
+	return classes, styles
+}
+
+// Add class or inline style for color. Palette colors get classes in Classes mode, RGB colors are always inline.
+func (o HTMLOptions) color(classes, styles *[]string, c Color, class, property string) {
+	if c == nil {
+		return
+	}
+	if v, ok := c.(PaletteColor); ok && o.Classes && v >= 0 && v <= 255 {
+		*classes = append(*classes, fmt.Sprintf("%s%s-%d", o.prefix(), class, int(v)))
+		return
+	}
+	if _, ok := c.(DefaultColor); ok {
+		return
+	}
+	*styles = append(*styles, property+":"+colorRGB(c, RGB{}).Hex())
+}
+
+// Get prefix of CSS classes.
+func (o HTMLOptions) prefix() string {
+	if o.ClassPrefix == "" {
+		return "gonsole-"
+	}
+	return o.ClassPrefix
+}
+
+// Get default foreground color.
+func (o HTMLOptions) defaultForeground() Color {
+	if o.Foreground == nil {
+		return COLOR_SILVER
+	}
+	return o.Foreground
+}
+
+// Get default background color.
+func (o HTMLOptions) defaultBackground() Color {
+	if o.Background == nil {
+		return COLOR_BLACK
+	}
+	return o.Background
+}
@@ -0,0 +1,70 @@
+package gonsole
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "plain", s: "a < b", want: "a &lt; b"},
+		{name: "basic", s: STD_COLOR_RED_FOREGROUND + "red" + DEFAULT + " plain", want: `<span style="color:#800000">red</span> plain`},
+		{name: "bright", s: STD_COLOR_BRIGHT_BLUE_BACKGROUND + "x", want: `<span style="background-color:#0000FF">x</span>`},
+		{name: "palette", s: COLOR_CORNFLOWER_BLUE.Foreground() + "x", want: `<span style="color:#5F87FF">x</span>`},
+		{name: "truecolor", s: "\x1b[38;2;1;2;3mx", want: `<span style="color:#010203">x</span>`},
+		{name: "attributes", s: BOLD + FAINT + ITALIC + "x", want: `<span style="font-weight:bold;opacity:0.5;font-style:italic">x</span>`},
+		{name: "decorations", s: "\x1b[4:3;9;53;58;5;9mx", want: `<span style="text-decoration-line:underline line-through overline;text-decoration-style:wavy;text-decoration-color:#FF0000">x</span>`},
+		{name: "inverse", s: INVERTED + COLOR_RED.Foreground() + "x", want: `<span style="color:#000000;background-color:#FF0000">x</span>`},
+		{name: "changes", s: BOLD + "a" + BOLD + "b" + NORMAL_INTENSITY + "c", want: `<span style="font-weight:bold">ab</span>c`},
+		{name: "other sequences dropped", s: "\x1b[2Ja\x1b]0;t\x07b", want: "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HTML(tt.s); got != tt.want {
+				t.Errorf("HTML() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTMLOptions_Render(t *testing.T) {
+	tests := []struct {
+		name    string
+		options HTMLOptions
+		s       string
+		want    string
+	}{
+		{name: "classes", options: HTMLOptions{Classes: true}, s: BOLD + COLOR_RED.Foreground() + COLOR_BLUE.Background() + "x", want: `<span class="gonsole-bold gonsole-fg-9 gonsole-bg-12">x</span>`},
+		{name: "prefix", options: HTMLOptions{Classes: true, ClassPrefix: "t-"}, s: "\x1b[4:2;9mx", want: `<span class="t-underline t-strike t-underline-double">x</span>`},
+		{name: "rgb inline", options: HTMLOptions{Classes: true}, s: "\x1b[3;38;2;1;2;3mx", want: `<span class="gonsole-italic" style="color:#010203">x</span>`},
+		{name: "inverse defaults", options: HTMLOptions{Foreground: RGB{R: 255, G: 255, B: 255}, Background: RGB{R: 1}}, s: INVERTED + "x", want: `<span style="color:#010000;background-color:#FFFFFF">x</span>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.Render(tt.s); got != tt.want {
+				t.Errorf("HTMLOptions.Render() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTMLOptions_Stylesheet(t *testing.T) {
+	css := HTMLOptions{}.Stylesheet()
+	for _, want := range []string{
+		".gonsole-bold{font-weight:bold}",
+		".gonsole-underline.gonsole-strike{text-decoration-line:underline line-through}",
+		".gonsole-underline-wavy{text-decoration-style:wavy}",
+		".gonsole-fg-0{color:#000000}",
+		".gonsole-bg-69{background-color:#5F87FF}",
+		".gonsole-ul-21{text-decoration-color:#0000FF}",
+		".gonsole-fg-255{color:#EEEEEE}",
+	} {
+		if !strings.Contains(css, want) {
+			t.Errorf("HTMLOptions.Stylesheet() does not contain %v", want)
+		}
+	}
+}
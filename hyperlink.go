@@ -0,0 +1,60 @@
+package gonsole
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sequence closing hyperlink.
+const hyperlinkEnd = "\x1b]8;;\x1b\\"
+
+// Get text shown as clickable link to url (OSC 8). Terminals without support show only text.
+func Hyperlink(url, text string) string {
+	return HyperlinkWithID(url, text, "")
+}
+
+// Get text shown as clickable link to url (OSC 8). Links with the same id and url are highlighted together,
+// for example when link is split across several lines.
+func HyperlinkWithID(url, text, id string) string {
+	params := ""
+	if id = hyperlinkID(id); id != "" {
+		params = "id=" + id
+	}
+	return fmt.Sprintf("\x1b]8;%s;%s\x1b\\%s", params, hyperlinkURL(url), text) + hyperlinkEnd
+}
+
+// Get url with bytes not allowed in OSC 8 percent-encoded.
+func hyperlinkURL(url string) string {
+	var result strings.Builder
+	for i := 0; i < len(url); i++ {
+		if url[i] < 0x20 || url[i] > 0x7E {
+			fmt.Fprintf(&result, "%%%02X", url[i])
+		} else {
+			result.WriteByte(url[i])
+		}
+	}
+	return result.String()
+}
+
+// Get id without characters separating OSC 8 parameters.
+func hyperlinkID(id string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E || r == ':' || r == ';' {
+			return -1
+		}
+		return r
+	}, id)
+}
+
+// Get url from OSC 8 sequence. Empty url closes link.
+func parseHyperlink(seq string) (string, bool) {
+	if !strings.HasPrefix(seq, "\x1b]8;") {
+		return "", false
+	}
+	body := strings.TrimSuffix(strings.TrimSuffix(seq[4:], "\x07"), "\x1b\\")
+	i := strings.IndexByte(body, ';')
+	if i < 0 {
+		return "", false
+	}
+	return body[i+1:], true
+}
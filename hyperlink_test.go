@@ -0,0 +1,41 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestHyperlink(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "link", got: Hyperlink("https://example.com", "site"), want: "\x1b]8;;https://example.com\x1b\\site\x1b]8;;\x1b\\"},
+		{name: "id", got: HyperlinkWithID("https://example.com", "site", "a1"), want: "\x1b]8;id=a1;https://example.com\x1b\\site\x1b]8;;\x1b\\"},
+		{name: "id separators dropped", got: HyperlinkWithID("http://x", "x", "a:b;c"), want: "\x1b]8;id=abc;http://x\x1b\\x\x1b]8;;\x1b\\"},
+		{name: "url encoded", got: Hyperlink("http://x/ж\x1b", "x"), want: "\x1b]8;;http://x/%D0%B6%1B\x1b\\x\x1b]8;;\x1b\\"},
+		{name: "styled", got: Style{}.Bold().Render(Hyperlink("http://x", "x")), want: BOLD + "\x1b]8;;http://x\x1b\\x\x1b]8;;\x1b\\\x1b[22m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHyperlink_Text(t *testing.T) {
+	link := HyperlinkWithID("https://example.com", "日本 site", "1")
+	if got := Strip(link); got != "日本 site" {
+		t.Errorf("Strip() = %q, want %q", got, "日本 site")
+	}
+	if got := Width(link); got != 9 {
+		t.Errorf("Width() = %v, want %v", got, 9)
+	}
+
+	want := "\x1b]8;id=1;https://example.com\x1b\\日本…\x1b]8;;\x1b\\"
+	if got := Truncate(link, 5, "…"); got != want {
+		t.Errorf("Truncate() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,152 @@
+package gonsole
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"unicode/utf8"
+)
+
+// ImageOptions configures rasterization of styled text with built-in bitmap font.
+type ImageOptions struct {
+	Columns int    // Number of terminal columns, longer lines are wrapped. Width of the longest line when 0
+	Scale   int    // Size of font pixel in image pixels, 1 when 0
+	Padding int    // Space around text in font pixels
+	Theme   *Theme // Terminal colors, DefaultTheme() when nil
+}
+
+// Get image of styled text drawn with built-in 6x10 bitmap font.
+// Characters missing in font, like non-ASCII ones, are drawn as boxes.
+func Image(s string) *image.RGBA {
+	return ImageOptions{}.Render(s)
+}
+
+// Write PNG image of styled text drawn with built-in bitmap font.
+func WritePNG(w io.Writer, s string) error {
+	return ImageOptions{}.WritePNG(w, s)
+}
+
+// Write PNG image of styled text drawn with built-in bitmap font.
+func (o ImageOptions) WritePNG(w io.Writer, s string) error {
+	return png.Encode(w, o.Render(s))
+}
+
+// Get image of styled text drawn with built-in bitmap font.
+// Bold, faint, hidden, inverted, underlined, crossed and overlined text is drawn, other attributes are ignored.
+func (o ImageOptions) Render(s string) *image.RGBA {
+	theme := DefaultTheme()
+	if o.Theme != nil {
+		theme = *o.Theme
+	}
+	c := canvas{scale: o.Scale}
+	if c.scale <= 0 {
+		c.scale = 1
+	}
+
+	lines := layoutGrid(s, o.Columns)
+	columns := o.Columns
+	if columns <= 0 {
+		// Empty text is one blank cell, PNG can not encode image without pixels
+		columns = atLeastOne(gridWidth(lines))
+	}
+	width := columns*fontCellWidth + 2*o.Padding
+	height := len(lines)*fontCellHeight + 2*o.Padding
+	c.img = image.NewRGBA(image.Rect(0, 0, width*c.scale, height*c.scale))
+	c.fill(0, 0, width, height, theme.Background)
+
+	for row, line := range lines {
+		for column, cell := range line {
+			if cell.width > 0 {
+				c.cell(theme, cell, o.Padding+column*fontCellWidth, o.Padding+row*fontCellHeight)
+			}
+		}
+	}
+	return c.img
+}
+
+// Image with pixels of built-in font scaled.
+type canvas struct {
+	img   *image.RGBA
+	scale int
+}
+
+// Draw character cell at position in font pixels.
+func (c canvas) cell(theme Theme, cell gridCell, x, y int) {
+	state := cell.state
+	fg, bg := theme.colors(state)
+	if state.Faint {
+		fg = RGB{R: uint8((int(fg.R) + int(bg.R)) / 2), G: uint8((int(fg.G) + int(bg.G)) / 2), B: uint8((int(fg.B) + int(bg.B)) / 2)}
+	}
+	width := cell.width * fontCellWidth
+
+	c.fill(x, y, width, fontCellHeight, bg)
+	if state.Hidden {
+		return
+	}
+
+	r, _ := utf8.DecodeRuneInString(cell.text)
+	if glyph, ok := fontGlyph(r); ok {
+		c.glyph(glyph, x, y+fontGlyphTop, fg)
+		if state.Bold {
+			c.glyph(glyph, x+1, y+fontGlyphTop, fg)
+		}
+	} else if r != ' ' {
+		// Box for characters missing in font
+		c.fill(x+1, y+fontGlyphTop, width-2, 1, fg)
+		c.fill(x+1, y+fontGlyphTop+6, width-2, 1, fg)
+		c.fill(x+1, y+fontGlyphTop, 1, 7, fg)
+		c.fill(x+width-2, y+fontGlyphTop, 1, 7, fg)
+	}
+
+	if state.Overlined {
+		c.fill(x, y, width, 1, fg)
+	}
+	if state.Crossed {
+		c.fill(x, y+fontGlyphTop+3, width, 1, fg)
+	}
+	if state.Underline != UNDERLINE_NONE {
+		ul := theme.RGB(state.UnderlineColor, fg)
+		bottom := y + fontCellHeight - 1
+		for i := 0; i < width; i++ {
+			switch state.Underline {
+			case UNDERLINE_DOUBLE:
+				c.fill(x+i, bottom-2, 1, 1, ul)
+				c.fill(x+i, bottom, 1, 1, ul)
+			case UNDERLINE_CURLY:
+				c.fill(x+i, bottom-(x+i)/2%2, 1, 1, ul)
+			case UNDERLINE_DOTTED:
+				if (x+i)%2 == 0 {
+					c.fill(x+i, bottom, 1, 1, ul)
+				}
+			case UNDERLINE_DASHED:
+				if (x+i)%4 != 3 {
+					c.fill(x+i, bottom, 1, 1, ul)
+				}
+			default:
+				c.fill(x+i, bottom, 1, 1, ul)
+			}
+		}
+	}
+}
+
+// Draw glyph with top left corner at position in font pixels.
+func (c canvas) glyph(glyph [8]uint8, x, y int, fg RGB) {
+	for row, bits := range glyph {
+		for column := 0; column < 5; column++ {
+			if bits&(0x10>>column) != 0 {
+				c.fill(x+column, y+row, 1, 1, fg)
+			}
+		}
+	}
+}
+
+// Fill rectangle in font pixels with color.
+func (c canvas) fill(x, y, width, height int, rgb RGB) {
+	col := color.RGBA{R: rgb.R, G: rgb.G, B: rgb.B, A: 0xFF}
+	for py := y * c.scale; py < (y+height)*c.scale; py++ {
+		for px := x * c.scale; px < (x+width)*c.scale; px++ {
+			c.img.SetRGBA(px, py, col)
+		}
+	}
+}
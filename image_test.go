@@ -0,0 +1,78 @@
+package gonsole
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestImage(t *testing.T) {
+	white := color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	black := color.RGBA{A: 0xFF}
+	silver := color.RGBA{R: 0xC0, G: 0xC0, B: 0xC0, A: 0xFF}
+	red := color.RGBA{R: 0xFF, A: 0xFF}
+	blue := color.RGBA{B: 0xFF, A: 0xFF}
+	tests := []struct {
+		name   string
+		s      string
+		size   image.Point
+		pixels map[image.Point]color.RGBA
+	}{
+		{name: "empty", s: "", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 3, Y: 3}: black}},
+		{name: "empty line", s: "\n", size: image.Point{X: 6, Y: 20}},
+		{name: "glyph", s: "!", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 2, Y: 1}: silver, {X: 2, Y: 6}: black, {X: 2, Y: 7}: silver, {X: 0, Y: 1}: black}},
+		{name: "colors", s: COLOR_RED.Foreground() + COLOR_BLUE.Background() + "!", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 2, Y: 1}: red, {X: 0, Y: 0}: blue}},
+		{name: "rgb", s: "\x1b[48;2;255;255;255m ", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 3, Y: 3}: white}},
+		{name: "inverse", s: INVERTED + " ", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 3, Y: 3}: silver}},
+		{name: "bold", s: BOLD + "!", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 3, Y: 1}: silver}},
+		{name: "underline", s: UNDERLINED + " ", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 0, Y: 9}: silver, {X: 5, Y: 9}: silver}},
+		{name: "strikethrough", s: CROSSED + " ", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 0, Y: 4}: silver}},
+		{name: "hidden", s: HIDE + "!", size: image.Point{X: 6, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 2, Y: 1}: black}},
+		{name: "wide box", s: "日", size: image.Point{X: 12, Y: 10}, pixels: map[image.Point]color.RGBA{{X: 1, Y: 1}: silver, {X: 10, Y: 7}: silver, {X: 5, Y: 4}: black}},
+		{name: "lines", s: "a\nbb", size: image.Point{X: 12, Y: 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := Image(tt.s)
+			if got := img.Bounds().Size(); got != tt.size {
+				t.Errorf("Image() size = %v, want %v", got, tt.size)
+			}
+			for p, want := range tt.pixels {
+				if got := img.RGBAAt(p.X, p.Y); got != want {
+					t.Errorf("Image() pixel %v = %v, want %v", p, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWritePNG_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, ""); err != nil {
+		t.Fatalf("WritePNG() error = %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if got, want := img.Bounds().Size(), (image.Point{X: 6, Y: 10}); got != want {
+		t.Errorf("WritePNG() size = %v, want %v", got, want)
+	}
+}
+
+func TestImageOptions_WritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (ImageOptions{Columns: 4, Scale: 2, Padding: 1}).WritePNG(&buf, COLOR_RED.Foreground()+"hello"); err != nil {
+		t.Fatalf("ImageOptions.WritePNG() error = %v", err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if got, want := img.Bounds().Size(), (image.Point{X: (4*6 + 2) * 2, Y: (2*10 + 2) * 2}); got != want {
+		t.Errorf("ImageOptions.WritePNG() size = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,436 @@
+package gonsole
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DEFAULT_ESCAPE_TIMEOUT = 50 * time.Millisecond // Time to wait for the rest of escape sequence after ESC
+	DEFAULT_QUERY_TIMEOUT  = time.Second           // Time to wait for reply to a query, long enough for remote sessions
+)
+
+// Maximal length of reply string kept until it is complete, enough for clipboard content.
+const maxReplyString = MAX_CLIPBOARD_PAYLOAD + maxPendingSequence
+
+// Error of queries when terminal does not reply in time, usually because it does not support the query.
+var ErrNoReply = errors.New("Terminal did not reply in time")
+
+// Event is an input event read by Decoder.
+type Event interface {
+	isEvent()
+}
+
+// UnknownEvent is an escape sequence not recognized by Decoder, like reply to a query not made by Decoder.
+type UnknownEvent struct {
+	Sequence string
+}
+
+func (UnknownEvent) isEvent() {}
+
+// Decoder reads terminal input, usually os.Stdin in raw mode, and decodes it into events.
+type Decoder struct {
+	// Time to wait for the rest of escape sequence. When it passes, ESC alone is reported as Escape key,
+	// and ESC with following byte is reported as Alt and that key. Zero means DEFAULT_ESCAPE_TIMEOUT.
+	Timeout time.Duration
+	Getenv  func(key string) string // Used instead of os.Getenv when set, to read COLORFGBG in IsDarkBackground
+
+	r      io.Reader
+	start  sync.Once
+	chunks chan inputChunk
+	buf    []byte
+	err    error
+	queue  []Event // Events read while waiting for reply to a query
+}
+
+// Bytes read from input in background.
+type inputChunk struct {
+	data []byte
+	err  error
+}
+
+// Create decoder reading from reader. Reader is read in background goroutine, which stops only when reader returns error.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Read next event. Blocks until event is decoded. Returns error of reader when all input before error is decoded.
+func (d *Decoder) ReadEvent() (Event, error) {
+	if len(d.queue) > 0 {
+		ev := d.queue[0]
+		d.queue = d.queue[1:]
+		return ev, nil
+	}
+	return d.next(nil)
+}
+
+// Decode next event from input. Returns ErrNoReply when deadline passes first.
+func (d *Decoder) next(deadline <-chan time.Time) (Event, error) {
+	d.start.Do(func() {
+		d.chunks = make(chan inputChunk)
+		go d.read()
+	})
+
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	startTimer := func(duration time.Duration) <-chan time.Time {
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.NewTimer(duration)
+		return timer.C
+	}
+
+	var timeout <-chan time.Time
+	replyTimeout := false
+	for {
+		reply := isReplyString(d.buf)
+		more := d.err == nil && (len(d.buf) < maxPendingSequence || isPaste(d.buf) || reply && len(d.buf) < maxReplyString)
+		if ev, n := decodeEvent(d.buf, more); n > 0 {
+			d.buf = d.buf[n:]
+			return ev, nil
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+
+		switch {
+		case len(d.buf) == 0:
+		case isPaste(d.buf):
+			// Pasted text is not limited in time
+			timeout = nil
+		case reply && deadline != nil:
+			// Reply string is limited by deadline of query
+			timeout = nil
+		case reply && !replyTimeout:
+			// Reply string may arrive slower than escape sequence, but must not hold following input forever
+			timeout, replyTimeout = startTimer(DEFAULT_QUERY_TIMEOUT), true
+		case timeout == nil:
+			timeout = startTimer(d.timeout())
+		}
+		select {
+		case c := <-d.chunks:
+			d.buf = append(d.buf, c.data...)
+			d.err = c.err
+		case <-timeout:
+			ev, n := decodeEvent(d.buf, false)
+			d.buf = d.buf[n:]
+			return ev, nil
+		case <-deadline:
+			return nil, ErrNoReply
+		}
+	}
+}
+
+// Wait for event matching function, usually reply to a query. Other events are kept and returned by ReadEvent later.
+// Zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) waitEvent(timeout time.Duration, match func(ev Event) bool) (Event, error) {
+	timer := queryTimer(timeout)
+	defer timer.Stop()
+	return d.waitUntil(timer.C, match)
+}
+
+// Wait for event matching function until deadline. Other events are kept and returned by ReadEvent later.
+func (d *Decoder) waitUntil(deadline <-chan time.Time, match func(ev Event) bool) (Event, error) {
+	for {
+		ev, err := d.next(deadline)
+		if err != nil {
+			return nil, err
+		}
+		if match(ev) {
+			return ev, nil
+		}
+		d.queue = append(d.queue, ev)
+	}
+}
+
+// Start timer for reply to a query. Zero timeout means DEFAULT_QUERY_TIMEOUT.
+func queryTimer(timeout time.Duration) *time.Timer {
+	if timeout <= 0 {
+		timeout = DEFAULT_QUERY_TIMEOUT
+	}
+	return time.NewTimer(timeout)
+}
+
+// Get channel of decoded events. Channel is closed when reader returns error, the error is available with Err.
+// Must not be mixed with ReadEvent and queries.
+func (d *Decoder) Events() <-chan Event {
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		for {
+			ev, err := d.ReadEvent()
+			if err != nil {
+				return
+			}
+			events <- ev
+		}
+	}()
+	return events
+}
+
+// Get error returned by reader, or nil when reader did not fail yet.
+func (d *Decoder) Err() error {
+	if len(d.buf) > 0 || len(d.queue) > 0 {
+		return nil
+	}
+	return d.err
+}
+
+// Get timeout for the rest of escape sequence.
+func (d *Decoder) timeout() time.Duration {
+	if d.Timeout <= 0 {
+		return DEFAULT_ESCAPE_TIMEOUT
+	}
+	return d.Timeout
+}
+
+// Read input into chunks until reader fails.
+func (d *Decoder) read() {
+	for {
+		buf := make([]byte, 256)
+		n, err := d.r.Read(buf)
+		if n == 0 && err == nil {
+			continue
+		}
+		d.chunks <- inputChunk{data: buf[:n], err: err}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Decode first event in b. When more input may follow b, incomplete event gives 0 length.
+// Otherwise non-empty b always gives an event.
+func decodeEvent(b []byte, more bool) (Event, int) {
+	if len(b) == 0 {
+		return nil, 0
+	}
+	if b[0] != 0x1b {
+		return decodeKey(b, more)
+	}
+	if isPaste(b) {
+		return decodePaste(b, more)
+	}
+
+	n, complete := inputSequenceLength(b)
+	switch {
+	case !complete && more:
+		return nil, 0
+	case !complete:
+		// Timed out sequence is ESC typed with Alt, or ESC alone
+		if len(b) == 1 {
+			return KeyEvent{Key: KEY_ESCAPE}, 1
+		}
+		if isReplyString(b) {
+			// Truncated reply, its bytes are not keys
+			return UnknownEvent{Sequence: string(b)}, len(b)
+		}
+		return altKey(b, false)
+	case n == 2:
+		// Introducer followed by control byte, like Alt+[ and Enter read at once
+		return altKey(b, more)
+	}
+
+	if strings.IndexByte("]PX^_", b[1]) >= 0 && !stringTerminated(b[:n]) {
+		// String ended with control byte, like Enter typed after truncated reply
+		return UnknownEvent{Sequence: string(b[:n])}, n
+	}
+
+	switch b[1] {
+	case '[':
+		return decodeCSI(b[:n]), n
+	case 'O':
+		return decodeSS3(b[:n]), n
+	case ']':
+		return decodeOSC(b[:n]), n
+	case 'P':
+		return decodeDCS(b[:n]), n
+	case 'X', '^', '_':
+		return UnknownEvent{Sequence: string(b[:n])}, n
+	}
+	return altKey(b, more)
+}
+
+// Decode ESC followed by key as the key with Alt.
+func altKey(b []byte, more bool) (Event, int) {
+	if b[1] == 0x1b {
+		// ESC before escape sequence is Alt in legacy terminals
+		if len(b) > 2 && (b[2] == '[' || b[2] == 'O') {
+			ev, n := decodeEvent(b[1:], more)
+			if n == 0 {
+				return nil, 0
+			}
+			if key, ok := ev.(KeyEvent); ok && key.Modifiers&MOD_ALT == 0 {
+				key.Modifiers |= MOD_ALT
+				return key, n + 1
+			}
+			return KeyEvent{Key: KEY_ESCAPE}, 1
+		}
+		return KeyEvent{Key: KEY_ESCAPE, Modifiers: MOD_ALT}, 2
+	}
+
+	ev, n := decodeKey(b[1:], more)
+	if n == 0 {
+		return nil, 0
+	}
+	key := ev.(KeyEvent)
+	key.Modifiers |= MOD_ALT
+	return key, n + 1
+}
+
+// Check whether input starts with reply string of OSC or DCS, which is longer than escape sequences and may arrive slower.
+// ESC with ] or P alone may be Alt key, so reply string must have its first byte: digit, or > and ! of XTVERSION
+// and DECRPTUI replies.
+func isReplyString(b []byte) bool {
+	if len(b) < 3 || b[0] != 0x1b || b[1] != ']' && b[1] != 'P' {
+		return false
+	}
+	c := b[2]
+	return c >= '0' && c <= '9' || b[1] == 'P' && (c == '>' || c == '!')
+}
+
+// Check whether string sequence ends with its terminator: ST, or BEL for OSC.
+func stringTerminated(seq []byte) bool {
+	return seq[1] == ']' && seq[len(seq)-1] == 0x07 || bytes.HasSuffix(seq, []byte("\x1b\\"))
+}
+
+// Get length of escape sequence at the start of b, which must start with ESC.
+// Returns false when sequence is not complete yet. Invalid byte ends sequence before it.
+func inputSequenceLength(b []byte) (int, bool) {
+	if len(b) < 2 {
+		return 1, false
+	}
+
+	switch b[1] {
+	case '[':
+		if len(b) > 2 && b[2] == '[' {
+			// Linux console function keys, like ESC [ [ A
+			if len(b) < 4 {
+				return len(b), false
+			}
+			return 4, true
+		}
+		if len(b) > 2 && b[2] == 'M' {
+			// X10 mouse encoding, with 3 raw bytes after M
+			if len(b) < 6 {
+				return len(b), false
+			}
+			return 6, true
+		}
+		private := len(b) > 2 && b[2] >= 0x3C && b[2] <= 0x3F
+		for i := 2; i < len(b); i++ {
+			switch c := b[i]; {
+			case c == '$' && !private && (i+1 == len(b) || b[i+1] != 'y'):
+				// rxvt Shift with editing keys, like ESC [ 2 $
+				return i + 1, true
+			case c >= 0x20 && c <= 0x3F:
+			case c >= 0x40 && c <= 0x7E:
+				return i + 1, true
+			default:
+				return i, true
+			}
+		}
+		return len(b), false
+	case 'O':
+		for i := 2; i < len(b); i++ {
+			switch c := b[i]; {
+			case c >= '0' && c <= '9' || c == ';':
+			case c >= 0x40 && c <= 0x7E:
+				return i + 1, true
+			default:
+				return i, true
+			}
+		}
+		return len(b), false
+	case ']', 'P', 'X', '^', '_':
+		for i := 2; i < len(b); i++ {
+			if b[i] == 0x07 && b[1] == ']' {
+				return i + 1, true
+			}
+			if b[i] < 0x20 && b[i] != 0x1b {
+				// Control byte is not part of string, like Enter typed after truncated reply
+				return i, true
+			}
+			if b[i] == 0x1b {
+				if i+1 == len(b) {
+					return len(b), false
+				}
+				if b[i+1] == '\\' {
+					return i + 2, true
+				}
+				return i, true
+			}
+		}
+		return len(b), false
+	}
+	return 1, true
+}
+
+// Decode OSC sequence, which terminal sends as reply to a query.
+func decodeOSC(seq []byte) Event {
+	body := strings.TrimSuffix(strings.TrimSuffix(string(seq[2:]), "\x07"), "\x1b\\")
+	if strings.HasPrefix(body, "52;") {
+		if ev, ok := clipboardReply(body[3:]); ok {
+			return ev
+		}
+	}
+	if ev, ok := colorReply(body); ok {
+		return ev
+	}
+	return UnknownEvent{Sequence: string(seq)}
+}
+
+// Parse CSI sequence into private marker, parameters, intermediate bytes and final byte.
+func splitCSI(seq []byte) (private byte, params string, intermediate string, final byte) {
+	body := seq[2 : len(seq)-1]
+	final = seq[len(seq)-1]
+	if len(body) > 0 && body[0] >= 0x3C && body[0] <= 0x3F {
+		private, body = body[0], body[1:]
+	}
+	i := len(body)
+	for i > 0 && body[i-1] >= 0x20 && body[i-1] <= 0x2F {
+		i--
+	}
+	return private, string(body[:i]), string(body[i:]), final
+}
+
+// Parse parameters of CSI sequence. Each parameter is a list of colon separated fields, missing fields are 0.
+func csiParams(params string) [][]int {
+	var result [][]int
+	if params == "" {
+		return result
+	}
+
+	start := 0
+	var fields []int
+	for i := 0; i <= len(params); i++ {
+		if i < len(params) && params[i] != ';' && params[i] != ':' {
+			continue
+		}
+		v, _ := sgrNumber(params[start:i])
+		fields = append(fields, v)
+		if i == len(params) || params[i] == ';' {
+			result = append(result, fields)
+			fields = nil
+		}
+		start = i + 1
+	}
+	return result
+}
+
+// Get field of parameter, or fallback when it is missing or zero.
+func csiParam(params [][]int, index, field, fallback int) int {
+	if index < 0 || index >= len(params) || field >= len(params[index]) || params[index][field] == 0 {
+		return fallback
+	}
+	return params[index][field]
+}
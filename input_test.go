@@ -0,0 +1,83 @@
+package gonsole
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecoder_ReadEvent(t *testing.T) {
+	d := NewDecoder(strings.NewReader("a\x1b[1;5A\x1b[\r\x1b[\x1b[A\x1b"))
+	want := []Event{
+		KeyEvent{Key: KEY_RUNE, Rune: 'a'},
+		KeyEvent{Key: KEY_UP, Modifiers: MOD_CTRL},
+		KeyEvent{Key: KEY_RUNE, Rune: '[', Modifiers: MOD_ALT},
+		KeyEvent{Key: KEY_ENTER},
+		KeyEvent{Key: KEY_RUNE, Rune: '[', Modifiers: MOD_ALT},
+		KeyEvent{Key: KEY_UP},
+		KeyEvent{Key: KEY_ESCAPE},
+	}
+	for i, w := range want {
+		got, err := d.ReadEvent()
+		if err != nil {
+			t.Fatalf("ReadEvent() #%d error = %v", i, err)
+		}
+		if got != w {
+			t.Errorf("ReadEvent() #%d = %#v, want %#v", i, got, w)
+		}
+	}
+	if _, err := d.ReadEvent(); err != io.EOF {
+		t.Errorf("ReadEvent() error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestDecoder_Timeout(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	d.Timeout = 20 * time.Millisecond
+
+	go func() {
+		// Sequence split across writes is joined
+		w.Write([]byte("\x1b["))
+		w.Write([]byte("B"))
+		// Lone ESC is reported after timeout
+		w.Write([]byte("\x1b"))
+		time.Sleep(40 * time.Millisecond)
+		// OSC introducer alone is Alt key
+		w.Write([]byte("\x1b]"))
+	}()
+
+	want := []Event{KeyEvent{Key: KEY_DOWN}, KeyEvent{Key: KEY_ESCAPE}, KeyEvent{Key: KEY_RUNE, Rune: ']', Modifiers: MOD_ALT}}
+	for i, w := range want {
+		got, err := d.ReadEvent()
+		if err != nil {
+			t.Fatalf("ReadEvent() #%d error = %v", i, err)
+		}
+		if got != w {
+			t.Errorf("ReadEvent() #%d = %#v, want %#v", i, got, w)
+		}
+	}
+}
+
+func TestDecoder_Events(t *testing.T) {
+	d := NewDecoder(strings.NewReader("x\x1b[3~\x1b[I"))
+	var got []Event
+	for ev := range d.Events() {
+		got = append(got, ev)
+	}
+
+	want := []Event{
+		KeyEvent{Key: KEY_RUNE, Rune: 'x'},
+		KeyEvent{Key: KEY_DELETE},
+		FocusEvent{Focused: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Events() = %#v, want %#v", got, want)
+	}
+	if err := d.Err(); err != io.EOF {
+		t.Errorf("Err() = %v, want %v", err, io.EOF)
+	}
+}
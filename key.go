@@ -0,0 +1,403 @@
+package gonsole
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Key is a key of keyboard event. Keys producing text are KEY_RUNE, with text in KeyEvent.Rune.
+type Key int
+
+const (
+	KEY_UNKNOWN Key = iota
+	KEY_RUNE
+	KEY_ENTER
+	KEY_TAB
+	KEY_BACKSPACE
+	KEY_ESCAPE
+	KEY_UP
+	KEY_DOWN
+	KEY_RIGHT
+	KEY_LEFT
+	KEY_BEGIN // Center of keypad
+	KEY_HOME
+	KEY_END
+	KEY_PAGE_UP
+	KEY_PAGE_DOWN
+	KEY_INSERT
+	KEY_DELETE
+	KEY_F1
+	KEY_F2
+	KEY_F3
+	KEY_F4
+	KEY_F5
+	KEY_F6
+	KEY_F7
+	KEY_F8
+	KEY_F9
+	KEY_F10
+	KEY_F11
+	KEY_F12
+	KEY_F13
+	KEY_F14
+	KEY_F15
+	KEY_F16
+	KEY_F17
+	KEY_F18
+	KEY_F19
+	KEY_F20
+	KEY_F21
+	KEY_F22
+	KEY_F23
+	KEY_F24
+)
+
+// Modifier is a set of modifier keys held with key, encoded the same way as in xterm and kitty sequences.
+type Modifier int
+
+const (
+	MOD_SHIFT Modifier = 1 << iota
+	MOD_ALT
+	MOD_CTRL
+	MOD_SUPER // Reported as Meta by xterm
+	MOD_HYPER
+	MOD_META
+	MOD_CAPS_LOCK
+	MOD_NUM_LOCK
+)
+
+// KeyAction is a kind of key event. Repeats and releases are reported only by kitty keyboard protocol.
+type KeyAction int
+
+const (
+	KEY_PRESS KeyAction = iota
+	KEY_REPEAT
+	KEY_RELEASE
+)
+
+// KittyKeyboardFlags are progressive enhancements of kitty keyboard protocol.
+type KittyKeyboardFlags int
+
+const (
+	KITTY_DISAMBIGUATE      KittyKeyboardFlags = 1 << iota // Report ambiguous keys, like Escape and Alt keys, with CSI u
+	KITTY_REPORT_EVENTS                                    // Report repeats and releases
+	KITTY_REPORT_ALTERNATES                                // Report shifted keys
+	KITTY_REPORT_ALL_KEYS                                  // Report all keys, including text keys, with CSI u
+	KITTY_REPORT_TEXT                                      // Report text produced by keys
+)
+
+// Disable kitty keyboard protocol enabled with EnableKittyKeyboard.
+const DISABLE_KITTY_KEYBOARD = "\x1b[<u"
+
+// KeyEvent is a key press, with modifiers held.
+type KeyEvent struct {
+	Key       Key
+	Rune      rune // Text of KEY_RUNE, for Ctrl with letter it is lowercase letter
+	Modifiers Modifier
+	Action    KeyAction
+}
+
+func (KeyEvent) isEvent() {}
+
+var keyNames = map[Key]string{
+	KEY_UNKNOWN:   "unknown",
+	KEY_RUNE:      "rune",
+	KEY_ENTER:     "enter",
+	KEY_TAB:       "tab",
+	KEY_BACKSPACE: "backspace",
+	KEY_ESCAPE:    "escape",
+	KEY_UP:        "up",
+	KEY_DOWN:      "down",
+	KEY_RIGHT:     "right",
+	KEY_LEFT:      "left",
+	KEY_BEGIN:     "begin",
+	KEY_HOME:      "home",
+	KEY_END:       "end",
+	KEY_PAGE_UP:   "pgup",
+	KEY_PAGE_DOWN: "pgdown",
+	KEY_INSERT:    "insert",
+	KEY_DELETE:    "delete",
+}
+
+var modifierNames = []struct {
+	mod  Modifier
+	name string
+}{
+	{mod: MOD_CTRL, name: "ctrl"},
+	{mod: MOD_ALT, name: "alt"},
+	{mod: MOD_SHIFT, name: "shift"},
+	{mod: MOD_SUPER, name: "super"},
+	{mod: MOD_HYPER, name: "hyper"},
+	{mod: MOD_META, name: "meta"},
+}
+
+// Get name of key, like "enter" or "f5".
+func (k Key) String() string {
+	if k >= KEY_F1 && k <= KEY_F24 {
+		return fmt.Sprintf("f%d", k-KEY_F1+1)
+	}
+	if name, ok := keyNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Get name of key with modifiers, like "ctrl+c", "shift+up" or "space". Lock modifiers are omitted.
+func (e KeyEvent) String() string {
+	var result strings.Builder
+	for _, m := range modifierNames {
+		if e.Modifiers&m.mod != 0 {
+			result.WriteString(m.name + "+")
+		}
+	}
+	switch {
+	case e.Key != KEY_RUNE:
+		result.WriteString(e.Key.String())
+	case e.Rune == ' ':
+		result.WriteString("space")
+	default:
+		result.WriteRune(e.Rune)
+	}
+	return result.String()
+}
+
+// Get text to enable kitty keyboard protocol with flags. Previous flags are restored with DISABLE_KITTY_KEYBOARD.
+func EnableKittyKeyboard(flags KittyKeyboardFlags) string {
+	return fmt.Sprintf("\x1b[>%du", int(flags))
+}
+
+// Decode key from input not starting with ESC: control character or UTF-8 encoded rune.
+func decodeKey(b []byte, more bool) (Event, int) {
+	c := b[0]
+	switch {
+	case c == 0x0d:
+		return KeyEvent{Key: KEY_ENTER}, 1
+	case c == 0x09:
+		return KeyEvent{Key: KEY_TAB}, 1
+	case c == 0x7f:
+		return KeyEvent{Key: KEY_BACKSPACE}, 1
+	case c == 0x00:
+		return KeyEvent{Key: KEY_RUNE, Rune: ' ', Modifiers: MOD_CTRL}, 1
+	case c < 0x1b:
+		return KeyEvent{Key: KEY_RUNE, Rune: rune('a' + c - 1), Modifiers: MOD_CTRL}, 1
+	case c < 0x20:
+		// Ctrl with \ ] ^ _
+		return KeyEvent{Key: KEY_RUNE, Rune: rune(c + 0x40), Modifiers: MOD_CTRL}, 1
+	case c < utf8.RuneSelf:
+		return KeyEvent{Key: KEY_RUNE, Rune: rune(c)}, 1
+	}
+
+	if more && !utf8.FullRune(b) {
+		return nil, 0
+	}
+	r, size := utf8.DecodeRune(b)
+	return KeyEvent{Key: KEY_RUNE, Rune: r}, size
+}
+
+// Keys of CSI sequences ending with ~, by first parameter. xterm sends F13 to F24 as F1 to F12 with Shift,
+// and they are reported as such, keeping key and modifiers actually pressed. Codes 42 to 45 are F21 to F24 of keyboards having them.
+var tildeKeys = map[int]Key{
+	1: KEY_HOME, 2: KEY_INSERT, 3: KEY_DELETE, 4: KEY_END, 5: KEY_PAGE_UP, 6: KEY_PAGE_DOWN, 7: KEY_HOME, 8: KEY_END,
+	11: KEY_F1, 12: KEY_F2, 13: KEY_F3, 14: KEY_F4, 15: KEY_F5, 17: KEY_F6, 18: KEY_F7, 19: KEY_F8, 20: KEY_F9, 21: KEY_F10,
+	23: KEY_F11, 24: KEY_F12, 25: KEY_F13, 26: KEY_F14, 28: KEY_F15, 29: KEY_F16, 31: KEY_F17, 32: KEY_F18, 33: KEY_F19, 34: KEY_F20,
+	42: KEY_F21, 43: KEY_F22, 44: KEY_F23, 45: KEY_F24,
+}
+
+// Sequence of rxvt key: first parameter and final byte.
+type rxvtKey struct {
+	code  int
+	final byte
+}
+
+// Keys F21 to F24 of rxvt, sent as shifted F11 and F12 and as F1 and F2 with Ctrl.
+var rxvtFunctionKeys = map[rxvtKey]Key{
+	{code: 23, final: '$'}: KEY_F21, {code: 24, final: '$'}: KEY_F22, {code: 11, final: '^'}: KEY_F23, {code: 12, final: '^'}: KEY_F24,
+}
+
+// Keys of CSI and SS3 sequences by final byte.
+var letterKeys = map[byte]Key{
+	'A': KEY_UP, 'B': KEY_DOWN, 'C': KEY_RIGHT, 'D': KEY_LEFT, 'E': KEY_BEGIN, 'F': KEY_END, 'H': KEY_HOME,
+	'P': KEY_F1, 'Q': KEY_F2, 'R': KEY_F3, 'S': KEY_F4,
+}
+
+// Keys of kitty keyboard protocol in private use area, other than function keys.
+var kittyKeys = map[int]KeyEvent{
+	57399: {Key: KEY_RUNE, Rune: '0'}, 57400: {Key: KEY_RUNE, Rune: '1'}, 57401: {Key: KEY_RUNE, Rune: '2'},
+	57402: {Key: KEY_RUNE, Rune: '3'}, 57403: {Key: KEY_RUNE, Rune: '4'}, 57404: {Key: KEY_RUNE, Rune: '5'},
+	57405: {Key: KEY_RUNE, Rune: '6'}, 57406: {Key: KEY_RUNE, Rune: '7'}, 57407: {Key: KEY_RUNE, Rune: '8'},
+	57408: {Key: KEY_RUNE, Rune: '9'}, 57409: {Key: KEY_RUNE, Rune: '.'}, 57410: {Key: KEY_RUNE, Rune: '/'},
+	57411: {Key: KEY_RUNE, Rune: '*'}, 57412: {Key: KEY_RUNE, Rune: '-'}, 57413: {Key: KEY_RUNE, Rune: '+'},
+	57414: {Key: KEY_ENTER}, 57415: {Key: KEY_RUNE, Rune: '='},
+	57417: {Key: KEY_LEFT}, 57418: {Key: KEY_RIGHT}, 57419: {Key: KEY_UP}, 57420: {Key: KEY_DOWN},
+	57421: {Key: KEY_PAGE_UP}, 57422: {Key: KEY_PAGE_DOWN}, 57423: {Key: KEY_HOME}, 57424: {Key: KEY_END},
+	57425: {Key: KEY_INSERT}, 57426: {Key: KEY_DELETE}, 57427: {Key: KEY_BEGIN},
+}
+
+// Decode CSI sequence.
+func decodeCSI(seq []byte) Event {
+	if len(seq) == 6 && seq[2] == 'M' {
+		return decodeX10Mouse(seq)
+	}
+	if !validFinal(seq) {
+		return UnknownEvent{Sequence: string(seq)}
+	}
+	if seq[2] == '[' {
+		// Linux console F1 to F5
+		if seq[3] >= 'A' && seq[3] <= 'E' {
+			return KeyEvent{Key: KEY_F1 + Key(seq[3]-'A')}
+		}
+		return UnknownEvent{Sequence: string(seq)}
+	}
+
+	private, params, intermediate, final := splitCSI(seq)
+	if private == 0 && params == "" && intermediate == "" && (final == 'I' || final == 'O') {
+		return FocusEvent{Focused: final == 'I'}
+	}
+	if intermediate == "" {
+		if mouse, ok := csiMouse(private, csiParams(params), final); ok {
+			return mouse
+		}
+	}
+	if ev, ok := csiReply(private, csiParams(params), intermediate, final); ok {
+		return ev
+	}
+	if private == 0 && intermediate == "" {
+		if key, ok := csiKey(csiParams(params), final); ok {
+			return key
+		}
+	}
+	return UnknownEvent{Sequence: string(seq)}
+}
+
+// Decode key from CSI parameters and final byte.
+func csiKey(params [][]int, final byte) (KeyEvent, bool) {
+	key := KeyEvent{Modifiers: csiModifiers(params), Action: csiAction(params)}
+	switch {
+	case final == 'Z':
+		key.Key = KEY_TAB
+		key.Modifiers |= MOD_SHIFT
+	case final >= 'a' && final <= 'd':
+		// rxvt Shift with arrows
+		key.Key = letterKeys[final-'a'+'A']
+		key.Modifiers = MOD_SHIFT
+	case final == 'u':
+		return kittyKey(params, key)
+	case final == '~' || final == '$' || final == '^' || final == '@':
+		code := csiParam(params, 0, 0, 0)
+		if code == 27 && len(params) == 3 {
+			// xterm modifyOtherKeys, like CSI 27 ; 5 ; 97 ~
+			return kittyKey(params[2:], key)
+		}
+		if k, ok := rxvtFunctionKeys[rxvtKey{code: code, final: final}]; ok && len(params) == 1 {
+			key.Key, key.Modifiers = k, 0
+			return key, true
+		}
+		k, ok := tildeKeys[code]
+		if !ok {
+			return key, false
+		}
+		key.Key = k
+		switch final {
+		case '$':
+			key.Modifiers = MOD_SHIFT
+		case '^':
+			key.Modifiers = MOD_CTRL
+		case '@':
+			key.Modifiers = MOD_CTRL | MOD_SHIFT
+		}
+	default:
+		k, ok := letterKeys[final]
+		if !ok {
+			return key, false
+		}
+		key.Key = k
+	}
+	return key, true
+}
+
+// Decode key of kitty keyboard protocol: code[:shifted[:base]] ; modifiers[:action] ; text.
+func kittyKey(params [][]int, key KeyEvent) (KeyEvent, bool) {
+	if len(params) == 0 {
+		return key, false
+	}
+
+	code := csiParam(params, 0, 0, 0)
+	switch {
+	case code == 9:
+		key.Key = KEY_TAB
+	case code == 13:
+		key.Key = KEY_ENTER
+	case code == 27:
+		key.Key = KEY_ESCAPE
+	case code == 8 || code == 127:
+		key.Key = KEY_BACKSPACE
+	case code >= 57376 && code <= 57387:
+		key.Key = KEY_F13 + Key(code-57376)
+	case code >= 57344 && code <= 63743:
+		k, ok := kittyKeys[code]
+		if !ok {
+			k = KeyEvent{Key: KEY_UNKNOWN}
+		}
+		key.Key, key.Rune = k.Key, k.Rune
+	case utf8.ValidRune(rune(code)) && code >= 0x20:
+		key.Key, key.Rune = KEY_RUNE, rune(code)
+		if shifted := csiParam(params, 0, 1, 0); shifted != 0 && key.Modifiers&MOD_SHIFT != 0 {
+			key.Rune = rune(shifted)
+		}
+	default:
+		return key, false
+	}
+	return key, true
+}
+
+// Get modifiers from second parameter, which is 1 plus modifier bits.
+func csiModifiers(params [][]int) Modifier {
+	m := csiParam(params, 1, 0, 1)
+	if m < 1 {
+		return 0
+	}
+	return Modifier(m - 1)
+}
+
+// Get kitty key action from second field of second parameter.
+func csiAction(params [][]int) KeyAction {
+	switch csiParam(params, 1, 1, 1) {
+	case 2:
+		return KEY_REPEAT
+	case 3:
+		return KEY_RELEASE
+	}
+	return KEY_PRESS
+}
+
+// Decode SS3 sequence, used for keys in application cursor mode and F1 to F4.
+func decodeSS3(seq []byte) Event {
+	if !validFinal(seq) {
+		return UnknownEvent{Sequence: string(seq)}
+	}
+	final := seq[len(seq)-1]
+	params := csiParams(string(seq[2 : len(seq)-1]))
+	mods := Modifier(0)
+	if m := csiParam(params, len(params)-1, 0, 1); m > 1 {
+		mods = Modifier(m - 1)
+	}
+
+	switch {
+	case final == 'M':
+		return KeyEvent{Key: KEY_ENTER, Modifiers: mods}
+	case final >= 'a' && final <= 'd':
+		// rxvt Ctrl with arrows
+		return KeyEvent{Key: letterKeys[final-'a'+'A'], Modifiers: MOD_CTRL}
+	}
+	if k, ok := letterKeys[final]; ok {
+		return KeyEvent{Key: k, Modifiers: mods}
+	}
+	return UnknownEvent{Sequence: string(seq)}
+}
+
+// Check whether sequence ended with final byte, not with invalid byte.
+func validFinal(seq []byte) bool {
+	final := seq[len(seq)-1]
+	return len(seq) > 2 && (final >= 0x40 && final <= 0x7E || final == '$')
+}
@@ -0,0 +1,138 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestDecodeKey(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+		n    int
+	}{
+		{name: "rune", in: "a", want: KeyEvent{Key: KEY_RUNE, Rune: 'a'}, n: 1},
+		{name: "utf8", in: "ж", want: KeyEvent{Key: KEY_RUNE, Rune: 'ж'}, n: 2},
+		{name: "incomplete utf8", in: "\xd0", want: nil, n: 0},
+		{name: "enter", in: "\r", want: KeyEvent{Key: KEY_ENTER}, n: 1},
+		{name: "tab", in: "\t", want: KeyEvent{Key: KEY_TAB}, n: 1},
+		{name: "backspace", in: "\x7f", want: KeyEvent{Key: KEY_BACKSPACE}, n: 1},
+		{name: "ctrl letter", in: "\x03", want: KeyEvent{Key: KEY_RUNE, Rune: 'c', Modifiers: MOD_CTRL}, n: 1},
+		{name: "ctrl space", in: "\x00", want: KeyEvent{Key: KEY_RUNE, Rune: ' ', Modifiers: MOD_CTRL}, n: 1},
+		{name: "ctrl backslash", in: "\x1c", want: KeyEvent{Key: KEY_RUNE, Rune: '\\', Modifiers: MOD_CTRL}, n: 1},
+		{name: "alt letter", in: "\x1bx", want: KeyEvent{Key: KEY_RUNE, Rune: 'x', Modifiers: MOD_ALT}, n: 2},
+		{name: "alt ctrl", in: "\x1b\x01", want: KeyEvent{Key: KEY_RUNE, Rune: 'a', Modifiers: MOD_ALT | MOD_CTRL}, n: 2},
+		{name: "escape pending", in: "\x1b", want: nil, n: 0},
+		{name: "arrow", in: "\x1b[A", want: KeyEvent{Key: KEY_UP}, n: 3},
+		{name: "arrow ss3", in: "\x1bOD", want: KeyEvent{Key: KEY_LEFT}, n: 3},
+		{name: "ctrl arrow xterm", in: "\x1b[1;5C", want: KeyEvent{Key: KEY_RIGHT, Modifiers: MOD_CTRL}, n: 6},
+		{name: "shift alt arrow", in: "\x1b[1;4B", want: KeyEvent{Key: KEY_DOWN, Modifiers: MOD_SHIFT | MOD_ALT}, n: 6},
+		{name: "shift arrow rxvt", in: "\x1b[a", want: KeyEvent{Key: KEY_UP, Modifiers: MOD_SHIFT}, n: 3},
+		{name: "ctrl arrow rxvt", in: "\x1bOd", want: KeyEvent{Key: KEY_LEFT, Modifiers: MOD_CTRL}, n: 3},
+		{name: "alt arrow legacy", in: "\x1b\x1b[A", want: KeyEvent{Key: KEY_UP, Modifiers: MOD_ALT}, n: 4},
+		{name: "home", in: "\x1b[H", want: KeyEvent{Key: KEY_HOME}, n: 3},
+		{name: "home tilde", in: "\x1b[1~", want: KeyEvent{Key: KEY_HOME}, n: 4},
+		{name: "end rxvt", in: "\x1b[8~", want: KeyEvent{Key: KEY_END}, n: 4},
+		{name: "page down", in: "\x1b[6~", want: KeyEvent{Key: KEY_PAGE_DOWN}, n: 4},
+		{name: "ctrl delete", in: "\x1b[3;5~", want: KeyEvent{Key: KEY_DELETE, Modifiers: MOD_CTRL}, n: 6},
+		{name: "shift insert rxvt", in: "\x1b[2$", want: KeyEvent{Key: KEY_INSERT, Modifiers: MOD_SHIFT}, n: 4},
+		{name: "ctrl page up rxvt", in: "\x1b[5^", want: KeyEvent{Key: KEY_PAGE_UP, Modifiers: MOD_CTRL}, n: 4},
+		{name: "shift tab", in: "\x1b[Z", want: KeyEvent{Key: KEY_TAB, Modifiers: MOD_SHIFT}, n: 3},
+		{name: "f1", in: "\x1bOP", want: KeyEvent{Key: KEY_F1}, n: 3},
+		{name: "shift f1", in: "\x1b[1;2P", want: KeyEvent{Key: KEY_F1, Modifiers: MOD_SHIFT}, n: 6},
+		{name: "f5", in: "\x1b[15~", want: KeyEvent{Key: KEY_F5}, n: 5},
+		{name: "f12", in: "\x1b[24~", want: KeyEvent{Key: KEY_F12}, n: 5},
+		{name: "f20", in: "\x1b[34~", want: KeyEvent{Key: KEY_F20}, n: 5},
+		{name: "f21", in: "\x1b[42~", want: KeyEvent{Key: KEY_F21}, n: 5},
+		{name: "shift f21 rxvt", in: "\x1b[42$", want: KeyEvent{Key: KEY_F21, Modifiers: MOD_SHIFT}, n: 5},
+		{name: "ctrl f21 rxvt", in: "\x1b[42^", want: KeyEvent{Key: KEY_F21, Modifiers: MOD_CTRL}, n: 5},
+		{name: "f13 xterm", in: "\x1b[1;2P", want: KeyEvent{Key: KEY_F1, Modifiers: MOD_SHIFT}, n: 6},
+		{name: "f17 xterm", in: "\x1b[15;2~", want: KeyEvent{Key: KEY_F5, Modifiers: MOD_SHIFT}, n: 7},
+		{name: "f24 xterm", in: "\x1b[24;2~", want: KeyEvent{Key: KEY_F12, Modifiers: MOD_SHIFT}, n: 7},
+		{name: "f25 xterm", in: "\x1b[1;5P", want: KeyEvent{Key: KEY_F1, Modifiers: MOD_CTRL}, n: 6},
+		{name: "f24", in: "\x1b[45~", want: KeyEvent{Key: KEY_F24}, n: 5},
+		{name: "ctrl f22", in: "\x1b[43;5~", want: KeyEvent{Key: KEY_F22, Modifiers: MOD_CTRL}, n: 7},
+		{name: "f21 rxvt", in: "\x1b[23$", want: KeyEvent{Key: KEY_F21}, n: 5},
+		{name: "f22 rxvt", in: "\x1b[24$", want: KeyEvent{Key: KEY_F22}, n: 5},
+		{name: "f23 rxvt", in: "\x1b[11^", want: KeyEvent{Key: KEY_F23}, n: 5},
+		{name: "f24 rxvt", in: "\x1b[12^", want: KeyEvent{Key: KEY_F24}, n: 5},
+		{name: "ctrl f3 rxvt", in: "\x1b[13^", want: KeyEvent{Key: KEY_F3, Modifiers: MOD_CTRL}, n: 5},
+		{name: "f3 linux console", in: "\x1b[[C", want: KeyEvent{Key: KEY_F3}, n: 4},
+		{name: "ctrl f4 ss3", in: "\x1bO5S", want: KeyEvent{Key: KEY_F4, Modifiers: MOD_CTRL}, n: 4},
+		{name: "incomplete csi", in: "\x1b[1;5", want: nil, n: 0},
+		{name: "kitty escape", in: "\x1b[27u", want: KeyEvent{Key: KEY_ESCAPE}, n: 5},
+		{name: "kitty ctrl letter", in: "\x1b[97;5u", want: KeyEvent{Key: KEY_RUNE, Rune: 'a', Modifiers: MOD_CTRL}, n: 7},
+		{name: "kitty shifted", in: "\x1b[97:65;2u", want: KeyEvent{Key: KEY_RUNE, Rune: 'A', Modifiers: MOD_SHIFT}, n: 10},
+		{name: "kitty release", in: "\x1b[97;1:3u", want: KeyEvent{Key: KEY_RUNE, Rune: 'a', Action: KEY_RELEASE}, n: 9},
+		{name: "kitty repeat arrow", in: "\x1b[1;1:2A", want: KeyEvent{Key: KEY_UP, Action: KEY_REPEAT}, n: 8},
+		{name: "kitty f24", in: "\x1b[57387u", want: KeyEvent{Key: KEY_F24}, n: 8},
+		{name: "kitty keypad", in: "\x1b[57400;5u", want: KeyEvent{Key: KEY_RUNE, Rune: '1', Modifiers: MOD_CTRL}, n: 10},
+		{name: "kitty modifier key", in: "\x1b[57441;2u", want: KeyEvent{Key: KEY_UNKNOWN, Modifiers: MOD_SHIFT}, n: 10},
+		{name: "kitty text", in: "\x1b[97;;97u", want: KeyEvent{Key: KEY_RUNE, Rune: 'a'}, n: 9},
+		{name: "modify other keys", in: "\x1b[27;5;13~", want: KeyEvent{Key: KEY_ENTER, Modifiers: MOD_CTRL}, n: 10},
+		{name: "unknown csi", in: "\x1b[?1x", want: UnknownEvent{Sequence: "\x1b[?1x"}, n: 5},
+		{name: "osc reply", in: "\x1b]1337;x\x07x", want: UnknownEvent{Sequence: "\x1b]1337;x\x07"}, n: 9},
+		{name: "dcs reply", in: "\x1bP1$r0m\x1b\\", want: UnknownEvent{Sequence: "\x1bP1$r0m\x1b\\"}, n: 9},
+		{name: "incomplete osc", in: "\x1b]11;rgb", want: nil, n: 0},
+		{name: "osc cut by enter", in: "\x1b]52;c;Y29weQ==\r", want: UnknownEvent{Sequence: "\x1b]52;c;Y29weQ=="}, n: 15},
+		{name: "osc cut by escape", in: "\x1b]11;rgb\x1b[A", want: UnknownEvent{Sequence: "\x1b]11;rgb"}, n: 8},
+		{name: "invalid csi", in: "\x1b[1\x01", want: UnknownEvent{Sequence: "\x1b[1"}, n: 3},
+		{name: "alt bracket enter", in: "\x1b[\r", want: KeyEvent{Key: KEY_RUNE, Rune: '[', Modifiers: MOD_ALT}, n: 2},
+		{name: "alt bracket arrow", in: "\x1b[\x1b[A", want: KeyEvent{Key: KEY_RUNE, Rune: '[', Modifiers: MOD_ALT}, n: 2},
+		{name: "alt O enter", in: "\x1bO\r", want: KeyEvent{Key: KEY_RUNE, Rune: 'O', Modifiers: MOD_ALT}, n: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := decodeEvent([]byte(tt.in), true)
+			if got != tt.want || n != tt.n {
+				t.Errorf("decodeEvent(%q) = %#v, %v, want %#v, %v", tt.in, got, n, tt.want, tt.n)
+			}
+		})
+	}
+}
+
+func TestDecodeKey_Timeout(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+		n    int
+	}{
+		{name: "escape", in: "\x1b", want: KeyEvent{Key: KEY_ESCAPE}, n: 1},
+		{name: "alt bracket", in: "\x1b[", want: KeyEvent{Key: KEY_RUNE, Rune: '[', Modifiers: MOD_ALT}, n: 2},
+		{name: "alt escape", in: "\x1b\x1b", want: KeyEvent{Key: KEY_ESCAPE, Modifiers: MOD_ALT}, n: 2},
+		{name: "alt O", in: "\x1bO", want: KeyEvent{Key: KEY_RUNE, Rune: 'O', Modifiers: MOD_ALT}, n: 2},
+		{name: "alt bracket unterminated", in: "\x1b]a", want: KeyEvent{Key: KEY_RUNE, Rune: ']', Modifiers: MOD_ALT}, n: 2},
+		{name: "truncated reply", in: "\x1b]52;c;Y29w", want: UnknownEvent{Sequence: "\x1b]52;c;Y29w"}, n: 11},
+		{name: "invalid utf8", in: "\xd0", want: KeyEvent{Key: KEY_RUNE, Rune: '�'}, n: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := decodeEvent([]byte(tt.in), false)
+			if got != tt.want || n != tt.n {
+				t.Errorf("decodeEvent(%q) = %#v, %v, want %#v, %v", tt.in, got, n, tt.want, tt.n)
+			}
+		})
+	}
+}
+
+func TestKeyEvent_String(t *testing.T) {
+	tests := []struct {
+		name string
+		key  KeyEvent
+		want string
+	}{
+		{name: "rune", key: KeyEvent{Key: KEY_RUNE, Rune: 'q'}, want: "q"},
+		{name: "space", key: KeyEvent{Key: KEY_RUNE, Rune: ' ', Modifiers: MOD_CTRL}, want: "ctrl+space"},
+		{name: "modifiers", key: KeyEvent{Key: KEY_UP, Modifiers: MOD_SHIFT | MOD_ALT | MOD_CTRL | MOD_NUM_LOCK}, want: "ctrl+alt+shift+up"},
+		{name: "function", key: KeyEvent{Key: KEY_F11}, want: "f11"},
+		{name: "page down", key: KeyEvent{Key: KEY_PAGE_DOWN}, want: "pgdown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.key.String(); got != tt.want {
+				t.Errorf("KeyEvent.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package gonsole
+
+import (
+	"fmt"
+)
+
+// MouseMode is a set of mouse events reported by terminal.
+type MouseMode int
+
+const (
+	MOUSE_X10          MouseMode = 9    // Button presses only
+	MOUSE_NORMAL       MouseMode = 1000 // Button presses and releases, and wheel
+	MOUSE_BUTTON_EVENT MouseMode = 1002 // Also motion while button is held
+	MOUSE_ANY_EVENT    MouseMode = 1003 // Also motion without buttons
+)
+
+// MouseEncoding is a form of mouse event sequences.
+type MouseEncoding int
+
+const (
+	MOUSE_ENCODING_X10   MouseEncoding = 0    // Default encoding, coordinates are limited to 223
+	MOUSE_ENCODING_SGR   MouseEncoding = 1006 // Recommended, reports which button is released
+	MOUSE_ENCODING_URXVT MouseEncoding = 1015
+)
+
+// Disable all mouse modes and encodings enabled with EnableMouse.
+const DISABLE_MOUSE = "\x1b[?1015l\x1b[?1006l\x1b[?1003l\x1b[?1002l\x1b[?1000l\x1b[?9l"
+
+// MouseButton is a button of mouse event. Wheel is reported as buttons too.
+type MouseButton int
+
+const (
+	MOUSE_BUTTON_NONE MouseButton = iota // Motion without buttons, or release in X10 and urxvt encodings
+	MOUSE_BUTTON_LEFT
+	MOUSE_BUTTON_MIDDLE
+	MOUSE_BUTTON_RIGHT
+	MOUSE_WHEEL_UP
+	MOUSE_WHEEL_DOWN
+	MOUSE_WHEEL_LEFT
+	MOUSE_WHEEL_RIGHT
+	MOUSE_BUTTON_BACKWARD
+	MOUSE_BUTTON_FORWARD
+)
+
+// MouseAction is a kind of mouse event.
+type MouseAction int
+
+const (
+	MOUSE_PRESS MouseAction = iota
+	MOUSE_RELEASE
+	MOUSE_MOTION
+)
+
+// MouseEvent is a mouse button press, release, wheel scroll or motion. Column and row start from 1.
+type MouseEvent struct {
+	Column    int
+	Row       int
+	Button    MouseButton
+	Action    MouseAction
+	Modifiers Modifier // Shift, Alt and Ctrl only, terminals usually keep some of them for own use
+}
+
+func (MouseEvent) isEvent() {}
+
+// Check whether event is wheel scroll.
+func (e MouseEvent) IsWheel() bool {
+	return e.Button >= MOUSE_WHEEL_UP && e.Button <= MOUSE_WHEEL_RIGHT
+}
+
+// Get text to enable mouse tracking mode with encoding. Disable it with DISABLE_MOUSE.
+func EnableMouse(mode MouseMode, encoding MouseEncoding) string {
+	result := fmt.Sprintf("\x1b[?%dh", int(mode))
+	if encoding != MOUSE_ENCODING_X10 {
+		result += fmt.Sprintf("\x1b[?%dh", int(encoding))
+	}
+	return result
+}
+
+// Decode mouse event in X10 encoding: CSI M followed by button, column and row, each increased by 32.
+func decodeX10Mouse(seq []byte) Event {
+	if seq[3] < 32 || seq[4] <= 32 || seq[5] <= 32 {
+		return UnknownEvent{Sequence: string(seq)}
+	}
+	return mouseEvent(int(seq[3])-32, int(seq[4])-32, int(seq[5])-32, false)
+}
+
+// Decode mouse event in SGR encoding (CSI < b ; x ; y M or m) or urxvt encoding (CSI b ; x ; y M).
+func csiMouse(private byte, params [][]int, final byte) (MouseEvent, bool) {
+	if len(params) != 3 || csiParam(params, 1, 0, 0) < 1 || csiParam(params, 2, 0, 0) < 1 {
+		return MouseEvent{}, false
+	}
+	button, column, row := csiParam(params, 0, 0, 0), params[1][0], params[2][0]
+
+	switch {
+	case private == '<' && (final == 'M' || final == 'm'):
+		ev := mouseEvent(button, column, row, true)
+		if final == 'm' {
+			ev.Action = MOUSE_RELEASE
+		}
+		return ev, true
+	case private == 0 && final == 'M' && button >= 32:
+		return mouseEvent(button-32, column, row, false), true
+	}
+	return MouseEvent{}, false
+}
+
+// Create mouse event from button code. Low two bits are button, 4, 8 and 16 are Shift, Alt and Ctrl,
+// 32 is motion, 64 and 128 select wheel and extra buttons. Without SGR encoding button 3 is release.
+func mouseEvent(code, column, row int, sgr bool) MouseEvent {
+	ev := MouseEvent{Column: column, Row: row}
+	if code&4 != 0 {
+		ev.Modifiers |= MOD_SHIFT
+	}
+	if code&8 != 0 {
+		ev.Modifiers |= MOD_ALT
+	}
+	if code&16 != 0 {
+		ev.Modifiers |= MOD_CTRL
+	}
+
+	low := code & 3
+	switch {
+	case code&128 != 0 && low < 2:
+		ev.Button = MOUSE_BUTTON_BACKWARD + MouseButton(low)
+	case code&128 != 0:
+		ev.Button = MOUSE_BUTTON_NONE
+	case code&64 != 0:
+		ev.Button = MOUSE_WHEEL_UP + MouseButton(low)
+	case low == 3:
+		ev.Button = MOUSE_BUTTON_NONE
+		if !sgr && code&32 == 0 {
+			ev.Action = MOUSE_RELEASE
+		}
+	default:
+		ev.Button = MOUSE_BUTTON_LEFT + MouseButton(low)
+	}
+	if code&32 != 0 {
+		ev.Action = MOUSE_MOTION
+	}
+	return ev
+}
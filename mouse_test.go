@@ -0,0 +1,79 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestEnableMouse(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     MouseMode
+		encoding MouseEncoding
+		want     string
+	}{
+		{name: "x10", mode: MOUSE_X10, encoding: MOUSE_ENCODING_X10, want: "\x1b[?9h"},
+		{name: "normal sgr", mode: MOUSE_NORMAL, encoding: MOUSE_ENCODING_SGR, want: "\x1b[?1000h\x1b[?1006h"},
+		{name: "button event urxvt", mode: MOUSE_BUTTON_EVENT, encoding: MOUSE_ENCODING_URXVT, want: "\x1b[?1002h\x1b[?1015h"},
+		{name: "any event", mode: MOUSE_ANY_EVENT, encoding: MOUSE_ENCODING_SGR, want: "\x1b[?1003h\x1b[?1006h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EnableMouse(tt.mode, tt.encoding); got != tt.want {
+				t.Errorf("EnableMouse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeMouse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+		n    int
+	}{
+		{name: "sgr press", in: "\x1b[<0;10;5M", want: MouseEvent{Column: 10, Row: 5, Button: MOUSE_BUTTON_LEFT}, n: 10},
+		{name: "sgr release", in: "\x1b[<2;1;1m", want: MouseEvent{Column: 1, Row: 1, Button: MOUSE_BUTTON_RIGHT, Action: MOUSE_RELEASE}, n: 9},
+		{name: "sgr wheel", in: "\x1b[<65;300;2M", want: MouseEvent{Column: 300, Row: 2, Button: MOUSE_WHEEL_DOWN}, n: 12},
+		{name: "sgr horizontal wheel", in: "\x1b[<66;3;2M", want: MouseEvent{Column: 3, Row: 2, Button: MOUSE_WHEEL_LEFT}, n: 10},
+		{name: "sgr drag", in: "\x1b[<33;4;4M", want: MouseEvent{Column: 4, Row: 4, Button: MOUSE_BUTTON_MIDDLE, Action: MOUSE_MOTION}, n: 10},
+		{name: "sgr motion", in: "\x1b[<35;4;4M", want: MouseEvent{Column: 4, Row: 4, Action: MOUSE_MOTION}, n: 10},
+		{name: "sgr modifiers", in: "\x1b[<28;7;8M", want: MouseEvent{Column: 7, Row: 8, Button: MOUSE_BUTTON_LEFT, Modifiers: MOD_SHIFT | MOD_ALT | MOD_CTRL}, n: 10},
+		{name: "sgr forward", in: "\x1b[<129;1;1M", want: MouseEvent{Column: 1, Row: 1, Button: MOUSE_BUTTON_FORWARD}, n: 11},
+		{name: "urxvt press", in: "\x1b[32;10;5M", want: MouseEvent{Column: 10, Row: 5, Button: MOUSE_BUTTON_LEFT}, n: 10},
+		{name: "urxvt release", in: "\x1b[35;10;5M", want: MouseEvent{Column: 10, Row: 5, Action: MOUSE_RELEASE}, n: 10},
+		{name: "x10 press", in: "\x1b[M !!", want: MouseEvent{Column: 1, Row: 1, Button: MOUSE_BUTTON_LEFT}, n: 6},
+		{name: "x10 release", in: "\x1b[M#*+", want: MouseEvent{Column: 10, Row: 11, Action: MOUSE_RELEASE}, n: 6},
+		{name: "x10 wheel up", in: "\x1b[M`!!", want: MouseEvent{Column: 1, Row: 1, Button: MOUSE_WHEEL_UP}, n: 6},
+		{name: "x10 large column", in: "\x1b[M \xff!", want: MouseEvent{Column: 223, Row: 1, Button: MOUSE_BUTTON_LEFT}, n: 6},
+		{name: "x10 incomplete", in: "\x1b[M !", want: nil, n: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := decodeEvent([]byte(tt.in), true)
+			if got != tt.want || n != tt.n {
+				t.Errorf("decodeEvent(%q) = %#v, %v, want %#v, %v", tt.in, got, n, tt.want, tt.n)
+			}
+		})
+	}
+}
+
+func TestMouseEvent_IsWheel(t *testing.T) {
+	tests := []struct {
+		name   string
+		button MouseButton
+		want   bool
+	}{
+		{name: "left", button: MOUSE_BUTTON_LEFT, want: false},
+		{name: "wheel up", button: MOUSE_WHEEL_UP, want: true},
+		{name: "wheel right", button: MOUSE_WHEEL_RIGHT, want: true},
+		{name: "backward", button: MOUSE_BUTTON_BACKWARD, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (MouseEvent{Button: tt.button}).IsWheel(); got != tt.want {
+				t.Errorf("MouseEvent.IsWheel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package gonsole
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Names and canonical values of every color in 256 colors palette. Hidden duplicates have no name.
+var paletteTable = [256]struct {
+	name string
+	rgb  RGB
+}{
+	{"BLACK", RGB{0x00, 0x00, 0x00}},                      // 0
+	{"MAROON", RGB{0x80, 0x00, 0x00}},                     // 1
+	{"OFFICE_GREEN", RGB{0x00, 0x80, 0x00}},               // 2
+	{"OLIVE", RGB{0x80, 0x80, 0x00}},                      // 3
+	{"NAVY_BLUE", RGB{0x00, 0x00, 0x80}},                  // 4
+	{"PURPLE", RGB{0x80, 0x00, 0x80}},                     // 5
+	{"TEAL", RGB{0x00, 0x80, 0x80}},                       // 6
+	{"SILVER", RGB{0xC0, 0xC0, 0xC0}},                     // 7
+	{"GRAY", RGB{0x80, 0x80, 0x80}},                       // 8
+	{"RED", RGB{0xFF, 0x00, 0x00}},                        // 9
+	{"GREEN", RGB{0x00, 0xFF, 0x00}},                      // 10
+	{"YELLOW", RGB{0xFF, 0xFF, 0x00}},                     // 11
+	{"BLUE", RGB{0x00, 0x00, 0xFF}},                       // 12
+	{"MAGENTA", RGB{0xFF, 0x00, 0xFF}},                    // 13
+	{"CYAN", RGB{0x00, 0xFF, 0xFF}},                       // 14
+	{"WHITE", RGB{0xFF, 0xFF, 0xFF}},                      // 15
+	{"", RGB{0x00, 0x00, 0x00}},                           // 16
+	{"DARK_NAVY_BLUE", RGB{0x00, 0x00, 0x5F}},             // 17
+	{"DARK_BLUE", RGB{0x00, 0x00, 0x87}},                  // 18
+	{"ZAFFRE", RGB{0x00, 0x00, 0xAF}},                     // 19
+	{"MEDIUM_BLUE", RGB{0x00, 0x00, 0xD7}},                // 20
+	{"", RGB{0x00, 0x00, 0xFF}},                           // 21
+	{"DARK_GREEN", RGB{0x00, 0x5F, 0x00}},                 // 22
+	{"CARIBBEAN_CURRENT", RGB{0x00, 0x5F, 0x5F}},          // 23
+	{"SEA_BLUE", RGB{0x00, 0x5F, 0x87}},                   // 24
+	{"LAPIS_LAZULI", RGB{0x00, 0x5F, 0xAF}},               // 25
+	{"TANG_BLUE", RGB{0x00, 0x5F, 0xD7}},                  // 26
+	{"ULTRAMARINE_BLUE", RGB{0x00, 0x5F, 0xFF}},           // 27
+	{"IRISH_GREEN", RGB{0x00, 0x87, 0x00}},                // 28
+	{"SEA_GREEN", RGB{0x00, 0x87, 0x5F}},                  // 29
+	{"DARK_CYAN", RGB{0x00, 0x87, 0x87}},                  // 30
+	{"BLUE_NCS", RGB{0x00, 0x87, 0xAF}},                   // 31
+	{"GREEN_BLUE", RGB{0x00, 0x87, 0xD7}},                 // 32
+	{"BLEU_DE_FRANCE", RGB{0x00, 0x87, 0xFF}},             // 33
+	{"ISLAMIC_GREEN", RGB{0x00, 0xAF, 0x00}},              // 34
+	{"PIGMENT_GREEN_CMYK_GREEN", RGB{0x00, 0xAF, 0x5F}},   // 35
+	{"JUNGLE_GREEN", RGB{0x00, 0xAF, 0x87}},               // 36
+	{"LIGHT_SEA_GREEN", RGB{0x00, 0xAF, 0xAF}},            // 37
+	{"BRIGHT_CERULEAN", RGB{0x00, 0xAF, 0xD7}},            // 38
+	{"DEEP_SKY_BLUE", RGB{0x00, 0xAF, 0xFF}},              // 39
+	{"LIME", RGB{0x00, 0xD7, 0x00}},                       // 40
+	{"MALACHITE", RGB{0x00, 0xD7, 0x5F}},                  // 41
+	{"AQUA_GREEN", RGB{0x00, 0xD7, 0x87}},                 // 42
+	{"CARIBBEAN_GREEN", RGB{0x00, 0xD7, 0xAF}},            // 43
+	{"DARK_TURQUOISE", RGB{0x00, 0xD7, 0xD7}},             // 44
+	{"BRIGHT_SKY_BLUE", RGB{0x00, 0xD7, 0xFF}},            // 45
+	{"", RGB{0x00, 0xFF, 0x00}},                           // 46
+	{"ERIN", RGB{0x00, 0xFF, 0x5F}},                       // 47
+	{"SPRING_GREEN", RGB{0x00, 0xFF, 0x87}},               // 48
+	{"MEDIUM_SPRING_GREEN", RGB{0x00, 0xFF, 0xAF}},        // 49
+	{"BRIGHT_TURQUOISE", RGB{0x00, 0xFF, 0xD7}},           // 50
+	{"", RGB{0x00, 0xFF, 0xFF}},                           // 51
+	{"BLOOD_RED", RGB{0x5F, 0x00, 0x00}},                  // 52
+	{"TYRIAN_PURPLE", RGB{0x5F, 0x00, 0x5F}},              // 53
+	{"INDIGO", RGB{0x5F, 0x00, 0x87}},                     // 54
+	{"DAISY_BUSH", RGB{0x5F, 0x00, 0xAF}},                 // 55
+	{"ELECTRIC_ULTRAMARINE", RGB{0x5F, 0x00, 0xD7}},       // 56
+	{"HAN_PURPLE_CHINESE_PURPLE", RGB{0x5F, 0x00, 0xFF}},  // 57
+	{"ANTIQUE_BRONZE", RGB{0x5F, 0x5F, 0x00}},             // 58
+	{"STORM_DUST", RGB{0x5F, 0x5F, 0x5F}},                 // 59
+	{"PURPLE_NAVY", RGB{0x5F, 0x5F, 0x87}},                // 60
+	{"RICH_BLUE", RGB{0x5F, 0x5F, 0xAF}},                  // 61
+	{"SLATE_BLUE", RGB{0x5F, 0x5F, 0xD7}},                 // 62
+	{"NEBULA_BLUE", RGB{0x5F, 0x5F, 0xFF}},                // 63
+	{"OLIVE_DRAB", RGB{0x5F, 0x87, 0x00}},                 // 64
+	{"RUSSIAN_GREEN", RGB{0x5F, 0x87, 0x5F}},              // 65
+	{"STEEL_TEAL", RGB{0x5F, 0x87, 0x87}},                 // 66
+	{"AIR_FORCE_BLUE", RGB{0x5F, 0x87, 0xAF}},             // 67
+	{"GLAUCOUS", RGB{0x5F, 0x87, 0xD7}},                   // 68
+	{"CORNFLOWER_BLUE", RGB{0x5F, 0x87, 0xFF}},            // 69
+	{"KELLY_GREEN", RGB{0x5F, 0xAF, 0x00}},                // 70
+	{"FERN", RGB{0x5F, 0xAF, 0x5F}},                       // 71
+	{"SHINY_SHAMROCK", RGB{0x5F, 0xAF, 0x87}},             // 72
+	{"VERDIGRIS", RGB{0x5F, 0xAF, 0xAF}},                  // 73
+	{"PICTON_BLUE", RGB{0x5F, 0xAF, 0xD7}},                // 74
+	{"FRENCH_SKY_BLUE", RGB{0x5F, 0xAF, 0xFF}},            // 75
+	{"LIME_GREEN", RGB{0x5F, 0xD7, 0x00}},                 // 76
+	{"PARIS_GREEN", RGB{0x5F, 0xD7, 0x5F}},                // 77
+	{"UFO_GREEN", RGB{0x5F, 0xD7, 0x87}},                  // 78
+	{"MEDIUM_AQUAMARINE", RGB{0x5F, 0xD7, 0xAF}},          // 79
+	{"MEDIUM_TURQUOISE", RGB{0x5F, 0xD7, 0xD7}},           // 80
+	{"VIVID_SKY_BLUE", RGB{0x5F, 0xD7, 0xFF}},             // 81
+	{"BRIGHT_GREEN", RGB{0x5F, 0xFF, 0x00}},               // 82
+	{"SCREAMIN_GREEN", RGB{0x5F, 0xFF, 0x5F}},             // 83
+	{"GUPPIE_GREEN", RGB{0x5F, 0xFF, 0x87}},               // 84
+	{"LIGHT_BLUISH_GREEN", RGB{0x5F, 0xFF, 0xAF}},         // 85
+	{"BLUE_ZIRCON", RGB{0x5F, 0xFF, 0xD7}},                // 86
+	{"AQUA", RGB{0x5F, 0xFF, 0xFF}},                       // 87
+	{"DARK_RED", RGB{0x87, 0x00, 0x00}},                   // 88
+	{"DARK_RASPBERRY", RGB{0x87, 0x00, 0x5F}},             // 89
+	{"MARDI_GRAS_PURPLE", RGB{0x87, 0x00, 0x87}},          // 90
+	{"GRAPE", RGB{0x87, 0x00, 0xAF}},                      // 91
+	{"DARK_VIOLET", RGB{0x87, 0x00, 0xD7}},                // 92
+	{"VIOLET_TRADITIONAL", RGB{0x87, 0x00, 0xFF}},         // 93
+	{"GOLDEN_BROWN", RGB{0x87, 0x5F, 0x00}},               // 94
+	{"DEEP_TAUPE", RGB{0x87, 0x5F, 0x5F}},                 // 95
+	{"FRENCH_LILAC", RGB{0x87, 0x5F, 0x87}},               // 96
+	{"DEEP_LILAC", RGB{0x87, 0x5F, 0xAF}},                 // 97
+	{"MEDIUM_PURPLE", RGB{0x87, 0x5F, 0xD7}},              // 98
+	{"MEDIUM_SLATE_BLUE", RGB{0x87, 0x5F, 0xFF}},          // 99
+	{"SWAMP_GREEN", RGB{0x87, 0x87, 0x00}},                // 100
+	{"DARK_TAN", RGB{0x87, 0x87, 0x5F}},                   // 101
+	{"BATTLESHIP_GRAY", RGB{0x87, 0x87, 0x87}},            // 102
+	{"WILD_BLUE_YONDER", RGB{0x87, 0x87, 0xAF}},           // 103
+	{"PORTAGE", RGB{0x87, 0x87, 0xD7}},                    // 104
+	{"LIGHT_SLATE_BLUE", RGB{0x87, 0x87, 0xFF}},           // 105
+	{"APPLE_GREEN", RGB{0x87, 0xAF, 0x00}},                // 106
+	{"OLIVINE", RGB{0x87, 0xAF, 0x5F}},                    // 107
+	{"DARK_SEA_GREEN", RGB{0x87, 0xAF, 0x87}},             // 108
+	{"MORNING_SKY_BLUE", RGB{0x87, 0xAF, 0xAF}},           // 109
+	{"RUDDY_BLUE", RGB{0x87, 0xAF, 0xD7}},                 // 110
+	{"JORDY_BLUE", RGB{0x87, 0xAF, 0xFF}},                 // 111
+	{"YELLOW_GREEN", RGB{0x87, 0xD7, 0x00}},               // 112
+	{"PASTEL_GREEN", RGB{0x87, 0xD7, 0x5F}},               // 113
+	{"GOSSIP", RGB{0x87, 0xD7, 0x87}},                     // 114
+	{"ALGAE_GREEN", RGB{0x87, 0xD7, 0xAF}},                // 115
+	{"MIDDLE_BLUE_GREEN", RGB{0x87, 0xD7, 0xD7}},          // 116
+	{"BABY_BLUE", RGB{0x87, 0xD7, 0xFF}},                  // 117
+	{"CHARTREUSE", RGB{0x87, 0xFF, 0x00}},                 // 118
+	{"SCREAMIN_GREEN_ULTRA_GREEN", RGB{0x87, 0xFF, 0x5F}}, // 119
+	{"ULTRA_GREEN", RGB{0x87, 0xFF, 0x87}},                // 120
+	{"BRIGHT_MINT", RGB{0x87, 0xFF, 0xAF}},                // 121
+	{"AQUAMARINE", RGB{0x87, 0xFF, 0xD7}},                 // 122
+	{"ELECTRIC_BLUE", RGB{0x87, 0xFF, 0xFF}},              // 123
+	{"TURKEY_RED", RGB{0xAF, 0x00, 0x00}},                 // 124
+	{"JAZZBERRY_JAM", RGB{0xAF, 0x00, 0x5F}},              // 125
+	{"FANDANGO", RGB{0xAF, 0x00, 0x87}},                   // 126
+	{"PURPLE_MUNSELL", RGB{0xAF, 0x00, 0xAF}},             // 127
+	{"DARK_ORCHID", RGB{0xAF, 0x00, 0xD7}},                // 128
+	{"VERONICA", RGB{0xAF, 0x00, 0xFF}},                   // 129
+	{"GINGER", RGB{0xAF, 0x5F, 0x00}},                     // 130
+	{"MIDDLE_RED_PURPLE", RGB{0xAF, 0x5F, 0x5F}},          // 131
+	{"PEARLY_PURPLE", RGB{0xAF, 0x5F, 0x87}},              // 132
+	{"DEEP_FUCHSIA", RGB{0xAF, 0x5F, 0xAF}},               // 133
+	{"RICH_LILAC", RGB{0xAF, 0x5F, 0xD7}},                 // 134
+	{"LAVENDER_INDIGO", RGB{0xAF, 0x5F, 0xFF}},            // 135
+	{"DARK_GOLDENROD", RGB{0xAF, 0x87, 0x00}},             // 136
+	{"LIGHT_TAUPE", RGB{0xAF, 0x87, 0x5F}},                // 137
+	{"ROSY_BROWN", RGB{0xAF, 0x87, 0x87}},                 // 138
+	{"OPERA_MAUVE", RGB{0xAF, 0x87, 0xAF}},                // 139
+	{"LAVENDER_FLORAL", RGB{0xAF, 0x87, 0xD7}},            // 140
+	{"TROPICAL_INDIGO", RGB{0xAF, 0x87, 0xFF}},            // 141
+	{"OLIVE_YELLOW", RGB{0xAF, 0xAF, 0x00}},               // 142
+	{"OLIVE_GREEN", RGB{0xAF, 0xAF, 0x5F}},                // 143
+	{"MISTY_MOSS", RGB{0xAF, 0xAF, 0x87}},                 // 144
+	{"NOBEL", RGB{0xAF, 0xAF, 0xAF}},                      // 145
+	{"MOON_RAKER", RGB{0xAF, 0xAF, 0xD7}},                 // 146
+	{"MAXIMUM_BLUE_PURPLE", RGB{0xAF, 0xAF, 0xFF}},        // 147
+	{"INCHWORM", RGB{0xAF, 0xD7, 0x00}},                   // 148
+	{"JUNE_BUD", RGB{0xAF, 0xD7, 0x5F}},                   // 149
+	{"GRANNY_SMITH_APPLE", RGB{0xAF, 0xD7, 0x87}},         // 150
+	{"CELADON", RGB{0xAF, 0xD7, 0xAF}},                    // 151
+	{"POWDER_BLUE", RGB{0xAF, 0xD7, 0xD7}},                // 152
+	{"PALE_CORNFLOWER_BLUE", RGB{0xAF, 0xD7, 0xFF}},       // 153
+	{"SPRING_BUD", RGB{0xAF, 0xFF, 0x00}},                 // 154
+	{"FRENCH_LIME", RGB{0xAF, 0xFF, 0x5F}},                // 155
+	{"MINT_GREEN", RGB{0xAF, 0xFF, 0x87}},                 // 156
+	{"PALE_GREEN", RGB{0xAF, 0xFF, 0xAF}},                 // 157
+	{"MAGIC_MINT", RGB{0xAF, 0xFF, 0xD7}},                 // 158
+	{"CELESTE", RGB{0xAF, 0xFF, 0xFF}},                    // 159
+	{"RACING_RED_ROSSO_CORSA", RGB{0xD7, 0x00, 0x00}},     // 160
+	{"DOGWOOD_ROSE", RGB{0xD7, 0x00, 0x5F}},               // 161
+	{"VIVID_CERISE", RGB{0xD7, 0x00, 0x87}},               // 162
+	{"BYZANTINE", RGB{0xD7, 0x00, 0xAF}},                  // 163
+	{"STEEL_PINK", RGB{0xD7, 0x00, 0xD7}},                 // 164
+	{"PSYCHEDELIC_PURPLE", RGB{0xD7, 0x00, 0xFF}},         // 165
+	{"COCOA_BROWN", RGB{0xD7, 0x5F, 0x00}},                // 166
+	{"INDIAN_RED", RGB{0xD7, 0x5F, 0x5F}},                 // 167
+	{"CINNAMON_SATIN", RGB{0xD7, 0x5F, 0x87}},             // 168
+	{"SKY_MAGENTA", RGB{0xD7, 0x5F, 0xAF}},                // 169
+	{"ORCHID", RGB{0xD7, 0x5F, 0xD7}},                     // 170
+	{"HELIOTROPE", RGB{0xD7, 0x5F, 0xFF}},                 // 171
+	{"HARVEST_GOLD", RGB{0xD7, 0x87, 0x00}},               // 172
+	{"PALE_COPPER", RGB{0xD7, 0x87, 0x5F}},                // 173
+	{"NEW_YORK_PINK", RGB{0xD7, 0x87, 0x87}},              // 174
+	{"MIDDLE_PURPLE", RGB{0xD7, 0x87, 0xAF}},              // 175
+	{"PLUM", RGB{0xD7, 0x87, 0xD7}},                       // 176
+	{"BRIGHT_LILAC", RGB{0xD7, 0x87, 0xFF}},               // 177
+	{"NEON_GOLD", RGB{0xD7, 0xAF, 0x00}},                  // 178
+	{"EARTH_YELLOW", RGB{0xD7, 0xAF, 0x5F}},               // 179
+	{"TAN", RGB{0xD7, 0xAF, 0x87}},                        // 180
+	{"PALE_CHESTNUT", RGB{0xD7, 0xAF, 0xAF}},              // 181
+	{"LILAC", RGB{0xD7, 0xAF, 0xD7}},                      // 182
+	{"MAUVE_MALLOW", RGB{0xD7, 0xAF, 0xFF}},               // 183
+	{"PERIDOT", RGB{0xD7, 0xD7, 0x00}},                    // 184
+	{"STRAW", RGB{0xD7, 0xD7, 0x5F}},                      // 185
+	{"GREEN_EARTH_VERONA_GREEN", RGB{0xD7, 0xD7, 0x87}},   // 186
+	{"PALE_SPRING_BUD", RGB{0xD7, 0xD7, 0xAF}},            // 187
+	{"TIMBERWOLF", RGB{0xD7, 0xD7, 0xD7}},                 // 188
+	{"LAVENDER_BLUE", RGB{0xD7, 0xD7, 0xFF}},              // 189
+	{"CHARTREUSE_YELLOW", RGB{0xD7, 0xFF, 0x00}},          // 190
+	{"FLUORESCENT_YELLOW", RGB{0xD7, 0xFF, 0x5F}},         // 191
+	{"KEY_LIME", RGB{0xD7, 0xFF, 0x87}},                   // 192
+	{"CANARY", RGB{0xD7, 0xFF, 0xAF}},                     // 193
+	{"TEA_GREEN", RGB{0xD7, 0xFF, 0xD7}},                  // 194
+	{"LIGHT_CYAN", RGB{0xD7, 0xFF, 0xFF}},                 // 195
+	{"", RGB{0xFF, 0x00, 0x00}},                           // 196
+	{"RADICAL_RED", RGB{0xFF, 0x00, 0x5F}},                // 197
+	{"ROSE", RGB{0xFF, 0x00, 0x87}},                       // 198
+	{"HOLLYWOOD_CERISE", RGB{0xFF, 0x00, 0xAF}},           // 199
+	{"HOT_MAGENTA", RGB{0xFF, 0x00, 0xD7}},                // 200
+	{"", RGB{0xFF, 0x00, 0xFF}},                           // 201
+	{"ORANGE_CRAYOLA", RGB{0xFF, 0x5F, 0x00}},             // 202
+	{"PASTEL_RED", RGB{0xFF, 0x5F, 0x5F}},                 // 203
+	{"LIGHT_CRIMSON", RGB{0xFF, 0x5F, 0x87}},              // 204
+	{"HOT_PINK", RGB{0xFF, 0x5F, 0xAF}},                   // 205
+	{"ROSE_PINK", RGB{0xFF, 0x5F, 0xD7}},                  // 206
+	{"FLUORESCENT_PINK", RGB{0xFF, 0x5F, 0xFF}},           // 207
+	{"DARK_ORANGE", RGB{0xFF, 0x87, 0x00}},                // 208
+	{"CORAL", RGB{0xFF, 0x87, 0x5F}},                      // 209
+	{"LIGHT_CORAL", RGB{0xFF, 0x87, 0x87}},                // 210
+	{"TICKLE_ME_PINK", RGB{0xFF, 0x87, 0xAF}},             // 211
+	{"PALE_MAGENTA", RGB{0xFF, 0x87, 0xD7}},               // 212
+	{"FUCHSIA_PINK", RGB{0xFF, 0x87, 0xFF}},               // 213
+	{"BRIGHT_YELLOW", RGB{0xFF, 0xAF, 0x00}},              // 214
+	{"SANDY_BROWN", RGB{0xFF, 0xAF, 0x5F}},                // 215
+	{"LIGHT_SALMON", RGB{0xFF, 0xAF, 0x87}},               // 216
+	{"LIGHT_PINK", RGB{0xFF, 0xAF, 0xAF}},                 // 217
+	{"LAVENDER_PINK", RGB{0xFF, 0xAF, 0xD7}},              // 218
+	{"ELECTRIC_LAVENDER", RGB{0xFF, 0xAF, 0xFF}},          // 219
+	{"GOLD", RGB{0xFF, 0xD7, 0x00}},                       // 220
+	{"DANDELION", RGB{0xFF, 0xD7, 0x5F}},                  // 221
+	{"MEDIUM_YELLOW", RGB{0xFF, 0xD7, 0x87}},              // 222
+	{"LIGHT_ORANGE", RGB{0xFF, 0xD7, 0xAF}},               // 223
+	{"PALE_PINK", RGB{0xFF, 0xD7, 0xD7}},                  // 224
+	{"PINK_LACE", RGB{0xFF, 0xD7, 0xFF}},                  // 225
+	{"", RGB{0xFF, 0xFF, 0x00}},                           // 226
+	{"LASER_LEMON", RGB{0xFF, 0xFF, 0x5F}},                // 227
+	{"PASTEL_YELLOW", RGB{0xFF, 0xFF, 0x87}},              // 228
+	{"LEMON_YELLOW", RGB{0xFF, 0xFF, 0xAF}},               // 229
+	{"LIGHT_GOLDENROD_YELLOW", RGB{0xFF, 0xFF, 0xD7}},     // 230
+	{"", RGB{0xFF, 0xFF, 0xFF}},                           // 231
+	{"ALMOST_BLACK", RGB{0x08, 0x08, 0x08}},               // 232
+	{"SMOKY_BLACK", RGB{0x12, 0x12, 0x12}},                // 233
+	{"NERO", RGB{0x1C, 0x1C, 0x1C}},                       // 234
+	{"EERIE_BLACK", RGB{0x26, 0x26, 0x26}},                // 235
+	{"DARK_CHARCOAL", RGB{0x30, 0x30, 0x30}},              // 236
+	{"JET_BLACK", RGB{0x3A, 0x3A, 0x3A}},                  // 237
+	{"ONYX", RGB{0x44, 0x44, 0x44}},                       // 238
+	{"MATTERHORN", RGB{0x4E, 0x4E, 0x4E}},                 // 239
+	{"DAVY_X27_S_GRAY", RGB{0x58, 0x58, 0x58}},            // 240
+	{"GRANITE_GRAY", RGB{0x62, 0x62, 0x62}},               // 241
+	{"DIM_GRAY", RGB{0x6C, 0x6C, 0x6C}},                   // 242
+	{"NICKEL", RGB{0x76, 0x76, 0x76}},                     // 243
+	{"", RGB{0x80, 0x80, 0x80}},                           // 244
+	{"ALUMINIUM", RGB{0x8A, 0x8A, 0x8A}},                  // 245
+	{"SUVA_GREY", RGB{0x94, 0x94, 0x94}},                  // 246
+	{"SPANISH_GRAY", RGB{0x9E, 0x9E, 0x9E}},               // 247
+	{"GRAY_CHATEAU", RGB{0xA8, 0xA8, 0xA8}},               // 248
+	{"DARK_GRAY", RGB{0xB2, 0xB2, 0xB2}},                  // 249
+	{"MEDIUM_GRAY", RGB{0xBC, 0xBC, 0xBC}},                // 250
+	{"NEON_SILVER", RGB{0xC6, 0xC6, 0xC6}},                // 251
+	{"LIGHT_GRAY", RGB{0xD0, 0xD0, 0xD0}},                 // 252
+	{"GAINSBORO", RGB{0xDA, 0xDA, 0xDA}},                  // 253
+	{"PLATINUM", RGB{0xE4, 0xE4, 0xE4}},                   // 254
+	{"ANTI_FLASH_WHITE", RGB{0xEE, 0xEE, 0xEE}},           // 255
+}
+
+// Index of palette colors by name, filled from paletteTable.
+var paletteByName = func() map[string]PaletteColor {
+	result := make(map[string]PaletteColor, len(paletteTable))
+	for i, entry := range paletteTable {
+		if entry.name != "" {
+			result[entry.name] = PaletteColor(i)
+		}
+	}
+	return result
+}()
+
+// Get canonical RGB value of palette color. Works for hidden duplicates too. Colors outside of palette are black.
+func (c PaletteColor) RGB() RGB {
+	if c < 0 || c > 255 {
+		return RGB{}
+	}
+	return paletteTable[c].rgb
+}
+
+// Get canonical hex value of palette color, like #5F87FF.
+func (c PaletteColor) Hex() string {
+	return c.RGB().Hex()
+}
+
+// Get name of palette color without COLOR_ prefix, like CORNFLOWER_BLUE. Hidden duplicates have empty name.
+func (c PaletteColor) Name() string {
+	if c < 0 || c > 255 {
+		return ""
+	}
+	return paletteTable[c].name
+}
+
+// Parse hex color in #RRGGBB, RRGGBB, #RGB or RGB form.
+func ParseHex(hex string) (RGB, error) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return RGB{}, errors.New("Hex color must be in #RRGGBB or #RGB form")
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return RGB{}, errors.New("Hex color must contain only hexadecimal digits")
+	}
+
+	return RGB{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v)}, nil
+}
+
+// Get palette color with exactly the same value as passed hex color.
+// If value present in palette several times, the lowest index is returned.
+func PaletteColorFromHex(hex string) (PaletteColor, error) {
+	c, err := ParseHex(hex)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, entry := range paletteTable {
+		if entry.rgb == c {
+			return PaletteColor(i), nil
+		}
+	}
+
+	return 0, errors.New("Color is not present in palette")
+}
+
+// Get palette color by its name, like CORNFLOWER_BLUE or COLOR_CORNFLOWER_BLUE. Name is case insensitive.
+func PaletteColorByName(name string) (PaletteColor, error) {
+	name = strings.TrimPrefix(strings.ToUpper(name), "COLOR_")
+	if c, ok := paletteByName[name]; ok {
+		return c, nil
+	}
+
+	return 0, errors.New("Unknown color name")
+}
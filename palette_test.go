@@ -0,0 +1,133 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestPaletteColor_Hex(t *testing.T) {
+	tests := []struct {
+		name string
+		c    PaletteColor
+		want string
+	}{
+		{name: "black", c: COLOR_BLACK, want: "#000000"},
+		{name: "silver", c: COLOR_SILVER, want: "#C0C0C0"},
+		{name: "hidden 16", c: 16, want: "#000000"},
+		{name: "hidden 21", c: 21, want: "#0000FF"},
+		{name: "hidden 46", c: 46, want: "#00FF00"},
+		{name: "hidden 51", c: 51, want: "#00FFFF"},
+		{name: "cornflower", c: COLOR_CORNFLOWER_BLUE, want: "#5F87FF"},
+		{name: "hidden gray", c: 244, want: "#808080"},
+		{name: "last", c: COLOR_ANTI_FLASH_WHITE, want: "#EEEEEE"},
+		{name: "out of palette", c: 256, want: "#000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Hex(); got != tt.want {
+				t.Errorf("PaletteColor.Hex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaletteColor_Name(t *testing.T) {
+	tests := []struct {
+		name string
+		c    PaletteColor
+		want string
+	}{
+		{name: "black", c: COLOR_BLACK, want: "BLACK"},
+		{name: "hidden", c: 16, want: ""},
+		{name: "cornflower", c: COLOR_CORNFLOWER_BLUE, want: "CORNFLOWER_BLUE"},
+		{name: "out of palette", c: -1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Name(); got != tt.want {
+				t.Errorf("PaletteColor.Name() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    RGB
+		wantErr bool
+	}{
+		{name: "full", hex: "#5F87FF", want: RGB{R: 0x5F, G: 0x87, B: 0xFF}, wantErr: false},
+		{name: "no hash", hex: "5f87ff", want: RGB{R: 0x5F, G: 0x87, B: 0xFF}, wantErr: false},
+		{name: "short", hex: "#F80", want: RGB{R: 0xFF, G: 0x88, B: 0x00}, wantErr: false},
+		{name: "wrong length", hex: "#5F87F", want: RGB{}, wantErr: true},
+		{name: "not hex", hex: "#5F87FG", want: RGB{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseHex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaletteColorFromHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		want    PaletteColor
+		wantErr bool
+	}{
+		{name: "standard", hex: "#C0C0C0", want: COLOR_SILVER, wantErr: false},
+		{name: "duplicate", hex: "#0000FF", want: COLOR_BLUE, wantErr: false},
+		{name: "cube", hex: "#5f87ff", want: COLOR_CORNFLOWER_BLUE, wantErr: false},
+		{name: "gray", hex: "#EEEEEE", want: COLOR_ANTI_FLASH_WHITE, wantErr: false},
+		{name: "missing", hex: "#123456", want: 0, wantErr: true},
+		{name: "invalid", hex: "#12", want: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PaletteColorFromHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PaletteColorFromHex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PaletteColorFromHex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaletteColorByName(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    PaletteColor
+		wantErr bool
+	}{
+		{name: "plain", arg: "CORNFLOWER_BLUE", want: COLOR_CORNFLOWER_BLUE, wantErr: false},
+		{name: "prefixed", arg: "COLOR_TEAL", want: COLOR_TEAL, wantErr: false},
+		{name: "lower case", arg: "anti_flash_white", want: COLOR_ANTI_FLASH_WHITE, wantErr: false},
+		{name: "unknown", arg: "NOT_A_COLOR", want: 0, wantErr: true},
+		{name: "empty", arg: "", want: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PaletteColorByName(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PaletteColorByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PaletteColorByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package gonsole
+
+import (
+	"bytes"
+	"strings"
+)
+
+const (
+	ENABLE_BRACKETED_PASTE  = "\x1b[?2004h" // Pasted text is reported as PasteEvent instead of keys
+	DISABLE_BRACKETED_PASTE = "\x1b[?2004l"
+)
+
+// Markers around pasted text in bracketed paste mode.
+const (
+	pasteStart = "\x1b[200~"
+	pasteEnd   = "\x1b[201~"
+)
+
+// PasteEvent is a text pasted in bracketed paste mode. Line breaks are converted to \n.
+type PasteEvent struct {
+	Text string
+}
+
+func (PasteEvent) isEvent() {}
+
+// Decode pasted text, b must start with paste start marker. Without end marker paste is incomplete,
+// unless no more input follows, then the rest of b is pasted text.
+func decodePaste(b []byte, more bool) (Event, int) {
+	text := b[len(pasteStart):]
+	n := len(b)
+	if end := bytes.Index(text, []byte(pasteEnd)); end >= 0 {
+		text = text[:end]
+		n = len(pasteStart) + end + len(pasteEnd)
+	} else if more {
+		return nil, 0
+	}
+
+	s := strings.ReplaceAll(string(text), "\r\n", "\n")
+	return PasteEvent{Text: strings.ReplaceAll(s, "\r", "\n")}, n
+}
+
+// Check whether input starts with pasted text.
+func isPaste(b []byte) bool {
+	return bytes.HasPrefix(b, []byte(pasteStart))
+}
@@ -0,0 +1,84 @@
+package gonsole
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodePaste(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		more bool
+		want Event
+		n    int
+	}{
+		{name: "paste", in: "\x1b[200~ls -la\x1b[201~", more: true, want: PasteEvent{Text: "ls -la"}, n: 18},
+		{name: "line breaks", in: "\x1b[200~a\rb\r\nc\nd\x1b[201~x", more: true, want: PasteEvent{Text: "a\nb\nc\nd"}, n: 20},
+		{name: "escape inside", in: "\x1b[200~\x1b[A\x1b[201~", more: true, want: PasteEvent{Text: "\x1b[A"}, n: 15},
+		{name: "empty", in: "\x1b[200~\x1b[201~", more: true, want: PasteEvent{}, n: 12},
+		{name: "incomplete", in: "\x1b[200~rm -rf\r", more: true, want: nil, n: 0},
+		{name: "input ended", in: "\x1b[200~rm", more: false, want: PasteEvent{Text: "rm"}, n: 8},
+		{name: "end alone", in: "\x1b[201~", more: true, want: UnknownEvent{Sequence: "\x1b[201~"}, n: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := decodeEvent([]byte(tt.in), tt.more)
+			if got != tt.want || n != tt.n {
+				t.Errorf("decodeEvent(%q) = %#v, %v, want %#v, %v", tt.in, got, n, tt.want, tt.n)
+			}
+		})
+	}
+}
+
+func TestDecoder_Paste(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	d.Timeout = 10 * time.Millisecond
+
+	text := strings.Repeat("line\r", 2000)
+	go func() {
+		// Slow and large paste is not split by timeout and sequence length limit
+		w.Write([]byte(pasteStart + text[:5000]))
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte(text[5000:] + pasteEnd + "q"))
+	}()
+
+	want := []Event{PasteEvent{Text: strings.Repeat("line\n", 2000)}, KeyEvent{Key: KEY_RUNE, Rune: 'q'}}
+	for i, w := range want {
+		got, err := d.ReadEvent()
+		if err != nil {
+			t.Fatalf("ReadEvent() #%d error = %v", i, err)
+		}
+		if got != w {
+			t.Errorf("ReadEvent() #%d = %.40v, want %.40v", i, got, w)
+		}
+	}
+}
+
+func TestDecoder_PasteSplitStart(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	d.Timeout = 20 * time.Millisecond
+
+	go func() {
+		// Paste start marker split across reads does not limit paste in time
+		w.Write([]byte(pasteStart[:4]))
+		time.Sleep(5 * time.Millisecond)
+		w.Write([]byte(pasteStart[4:] + "line1\r"))
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("rm -rf\r" + pasteEnd))
+	}()
+
+	got, err := d.ReadEvent()
+	if err != nil {
+		t.Fatalf("ReadEvent() error = %v", err)
+	}
+	if want := (PasteEvent{Text: "line1\nrm -rf\n"}); got != want {
+		t.Errorf("ReadEvent() = %#v, want %#v", got, want)
+	}
+}
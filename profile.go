@@ -0,0 +1,182 @@
+package gonsole
+
+import (
+	"os"
+	"strings"
+)
+
+// Profile is a set of colors output is able to show.
+// Profiles are ordered, so higher profile supports everything lower profile supports.
+type Profile int
+
+const (
+	PROFILE_NO_COLOR  Profile = iota // No escape sequences at all
+	PROFILE_ANSI                     // 16 standard colors, STD_COLOR_* sequences
+	PROFILE_ANSI256                  // 256 colors palette, COLOR_* constants
+	PROFILE_TRUECOLOR                // 24-bit RGB colors
+)
+
+// Detector decides which color profile output supports.
+// Zero value uses process environment and real terminal check.
+type Detector struct {
+	Getenv     func(key string) string // Used instead of os.Getenv when set
+	IsTerminal func(fd uintptr) bool   // Used instead of real terminal check when set
+}
+
+// CI services which render colors in job logs even though output is not a terminal.
+var ciProfiles = []struct {
+	env     string
+	profile Profile
+}{
+	{env: "GITHUB_ACTIONS", profile: PROFILE_TRUECOLOR},
+	{env: "GITEA_ACTIONS", profile: PROFILE_TRUECOLOR},
+	{env: "GITLAB_CI", profile: PROFILE_ANSI256},
+	{env: "BUILDKITE", profile: PROFILE_ANSI256},
+	{env: "CIRCLECI", profile: PROFILE_ANSI},
+	{env: "TRAVIS", profile: PROFILE_ANSI},
+	{env: "APPVEYOR", profile: PROFILE_ANSI},
+	{env: "DRONE", profile: PROFILE_ANSI},
+}
+
+// TERM prefixes of terminals known to support at least 16 colors.
+var ansiTerms = []string{"xterm", "screen", "tmux", "vt100", "vt220", "rxvt", "color", "ansi", "cygwin", "linux", "konsole", "putty", "alacritty", "foot", "wezterm"}
+
+// Get name of profile.
+func (p Profile) String() string {
+	switch p {
+	case PROFILE_NO_COLOR:
+		return "no color"
+	case PROFILE_ANSI:
+		return "ansi"
+	case PROFILE_ANSI256:
+		return "ansi256"
+	case PROFILE_TRUECOLOR:
+		return "truecolor"
+	}
+	return "unknown"
+}
+
+// Detect color profile of passed output file using process environment.
+func DetectProfile(f *os.File) Profile {
+	return Detector{}.Profile(f.Fd())
+}
+
+// Detect color profile of output with passed file descriptor.
+//
+// NO_COLOR disables colors, FORCE_COLOR and CLICOLOR_FORCE enable them even when output is not a terminal.
+// FORCE_COLOR values 1, 2 and 3 force PROFILE_ANSI, PROFILE_ANSI256 and PROFILE_TRUECOLOR respectively,
+// values 0, false and no force PROFILE_NO_COLOR even when output is a terminal.
+func (d Detector) Profile(fd uintptr) Profile {
+	getenv := d.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+	isTerm := d.IsTerminal
+	if isTerm == nil {
+		isTerm = isTerminal
+	}
+
+	forced, isForced := forcedProfile(getenv)
+	if isForced && forced == PROFILE_NO_COLOR || !isForced && getenv("NO_COLOR") != "" {
+		return PROFILE_NO_COLOR
+	}
+
+	profile := PROFILE_NO_COLOR
+	switch {
+	case isTerm(fd):
+		profile = termProfile(getenv)
+	case getenv("CI") != "" || getenv("GITHUB_ACTIONS") != "":
+		for _, ci := range ciProfiles {
+			if getenv(ci.env) != "" {
+				profile = ci.profile
+				break
+			}
+		}
+	}
+
+	if isForced && profile < forced {
+		return forced
+	}
+	return profile
+}
+
+// Get profile required by FORCE_COLOR or CLICOLOR_FORCE, and whether profile is forced at all.
+func forcedProfile(getenv func(string) string) (Profile, bool) {
+	switch v := strings.ToLower(getenv("FORCE_COLOR")); v {
+	case "":
+	case "0", "false", "no":
+		return PROFILE_NO_COLOR, true
+	case "2":
+		return PROFILE_ANSI256, true
+	case "3":
+		return PROFILE_TRUECOLOR, true
+	default:
+		return PROFILE_ANSI, true
+	}
+
+	if v := getenv("CLICOLOR_FORCE"); v != "" && v != "0" {
+		return PROFILE_ANSI, true
+	}
+	return PROFILE_NO_COLOR, false
+}
+
+// Get profile of terminal from TERM, COLORTERM and terminal program variables.
+func termProfile(getenv func(string) string) Profile {
+	term := strings.ToLower(getenv("TERM"))
+	if term == "dumb" {
+		return PROFILE_NO_COLOR
+	}
+
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return PROFILE_TRUECOLOR
+	}
+	if strings.Contains(term, "truecolor") || strings.Contains(term, "24bit") || strings.HasSuffix(term, "-direct") {
+		return PROFILE_TRUECOLOR
+	}
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode":
+		return PROFILE_TRUECOLOR
+	case "Apple_Terminal":
+		return PROFILE_ANSI256
+	}
+	if getenv("WT_SESSION") != "" {
+		return PROFILE_TRUECOLOR
+	}
+
+	if strings.Contains(term, "256color") {
+		return PROFILE_ANSI256
+	}
+	for _, prefix := range ansiTerms {
+		if strings.HasPrefix(term, prefix) {
+			return PROFILE_ANSI
+		}
+	}
+	if getenv("COLORTERM") != "" {
+		return PROFILE_ANSI
+	}
+
+	return PROFILE_NO_COLOR
+}
+
+// Convert color to the nearest color profile supports. Returns nil for PROFILE_NO_COLOR.
+func (p Profile) Convert(c Color) Color {
+	if p == PROFILE_NO_COLOR {
+		return nil
+	}
+
+	switch v := c.(type) {
+	case RGB:
+		switch p {
+		case PROFILE_ANSI:
+			return v.Nearest16()
+		case PROFILE_ANSI256:
+			return v.Nearest256()
+		}
+	case PaletteColor:
+		if p == PROFILE_ANSI {
+			return v.Nearest16()
+		}
+	}
+	return c
+}
@@ -0,0 +1,15 @@
+//go:build linux
+
+package gonsole
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// Check whether file descriptor is a terminal by requesting its termios settings.
+func isTerminal(fd uintptr) bool {
+	var termios syscall.Termios
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TCGETS, uintptr(unsafe.Pointer(&termios)))
+	return errno == 0
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd && !windows
+
+package gonsole
+
+// Terminal check is not implemented for this platform, so output is never treated as a terminal.
+func isTerminal(fd uintptr) bool {
+	return false
+}
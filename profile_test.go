@@ -0,0 +1,65 @@
+package gonsole
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDetector_Profile(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		tty  bool
+		want Profile
+	}{
+		{name: "pipe", env: map[string]string{"TERM": "xterm-256color"}, tty: false, want: PROFILE_NO_COLOR},
+		{name: "empty term", env: map[string]string{}, tty: true, want: PROFILE_NO_COLOR},
+		{name: "dumb", env: map[string]string{"TERM": "dumb", "COLORTERM": "truecolor"}, tty: true, want: PROFILE_NO_COLOR},
+		{name: "xterm", env: map[string]string{"TERM": "xterm"}, tty: true, want: PROFILE_ANSI},
+		{name: "linux console", env: map[string]string{"TERM": "linux"}, tty: true, want: PROFILE_ANSI},
+		{name: "256 colors", env: map[string]string{"TERM": "xterm-256color"}, tty: true, want: PROFILE_ANSI256},
+		{name: "colorterm", env: map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor"}, tty: true, want: PROFILE_TRUECOLOR},
+		{name: "direct term", env: map[string]string{"TERM": "xterm-direct"}, tty: true, want: PROFILE_TRUECOLOR},
+		{name: "apple terminal", env: map[string]string{"TERM": "xterm", "TERM_PROGRAM": "Apple_Terminal"}, tty: true, want: PROFILE_ANSI256},
+		{name: "no color", env: map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}, tty: true, want: PROFILE_NO_COLOR},
+		{name: "empty no color", env: map[string]string{"TERM": "xterm-256color", "NO_COLOR": ""}, tty: true, want: PROFILE_ANSI256},
+		{name: "force color pipe", env: map[string]string{"FORCE_COLOR": "1"}, tty: false, want: PROFILE_ANSI},
+		{name: "force color level", env: map[string]string{"FORCE_COLOR": "3"}, tty: false, want: PROFILE_TRUECOLOR},
+		{name: "force color keeps better", env: map[string]string{"FORCE_COLOR": "1", "TERM": "xterm-256color"}, tty: true, want: PROFILE_ANSI256},
+		{name: "force color disabled", env: map[string]string{"FORCE_COLOR": "0"}, tty: false, want: PROFILE_NO_COLOR},
+		{name: "force color disabled tty", env: map[string]string{"FORCE_COLOR": "false", "TERM": "xterm-256color"}, tty: true, want: PROFILE_NO_COLOR},
+		{name: "force color disabled beats clicolor", env: map[string]string{"FORCE_COLOR": "no", "CLICOLOR_FORCE": "1"}, tty: true, want: PROFILE_NO_COLOR},
+		{name: "force beats no color", env: map[string]string{"CLICOLOR_FORCE": "1", "NO_COLOR": "1"}, tty: false, want: PROFILE_ANSI},
+		{name: "github actions", env: map[string]string{"CI": "true", "GITHUB_ACTIONS": "true"}, tty: false, want: PROFILE_TRUECOLOR},
+		{name: "gitlab", env: map[string]string{"CI": "true", "GITLAB_CI": "true"}, tty: false, want: PROFILE_ANSI256},
+		{name: "unknown ci", env: map[string]string{"CI": "true"}, tty: false, want: PROFILE_NO_COLOR},
+		{name: "ci with no color", env: map[string]string{"CI": "true", "GITHUB_ACTIONS": "true", "NO_COLOR": "1"}, tty: false, want: PROFILE_NO_COLOR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Detector{
+				Getenv:     func(key string) string { return tt.env[key] },
+				IsTerminal: func(fd uintptr) bool { return fd == 42 && tt.tty },
+			}
+			if got := d.Profile(42); got != tt.want {
+				t.Errorf("Detector.Profile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectProfile(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	t.Setenv("FORCE_COLOR", "")
+	t.Setenv("CLICOLOR_FORCE", "")
+	t.Setenv("CI", "")
+	t.Setenv("GITHUB_ACTIONS", "")
+	if got := DetectProfile(f); got != PROFILE_NO_COLOR {
+		t.Errorf("DetectProfile() = %v, want %v", got, PROFILE_NO_COLOR)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+package gonsole
+
+import (
+	"syscall"
+)
+
+// Check whether handle is a console by requesting its mode.
+func isTerminal(fd uintptr) bool {
+	var mode uint32
+	return syscall.GetConsoleMode(syscall.Handle(fd), &mode) == nil
+}
@@ -0,0 +1,62 @@
+package gonsole
+
+import (
+	"errors"
+	"fmt"
+)
+
+// RGB is a 24-bit (truecolor) color.
+type RGB struct {
+	R uint8
+	G uint8
+	B uint8
+}
+
+// DefaultColor is the implementation defined terminal color, it resets foreground, background or underline color.
+type DefaultColor struct{}
+
+// Create RGB color from passed values. Color must be between 0 and 255 (included)
+func NewRGB(r, g, b int) (RGB, error) {
+	if r < 0 || r > 255 || g < 0 || g > 255 || b < 0 || b > 255 {
+		return RGB{}, errors.New("Color value must be between 0 and 255")
+	}
+
+	return RGB{R: uint8(r), G: uint8(g), B: uint8(b)}, nil
+}
+
+// Get text to set RGB color as foreground color.
+func (c RGB) Foreground() string {
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", c.R, c.G, c.B)
+}
+
+// Get text to set RGB color as background color.
+func (c RGB) Background() string {
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm", c.R, c.G, c.B)
+}
+
+// Get text to set RGB color as underline color.
+// Not in standard; implemented in Kitty, VTE, mintty, and iTerm2.
+func (c RGB) Underline() string {
+	return fmt.Sprintf("\x1b[58;2;%d;%d;%dm", c.R, c.G, c.B)
+}
+
+// Get hex representation of color, like #5F87FF.
+func (c RGB) Hex() string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// Get text to reset foreground color.
+func (DefaultColor) Foreground() string {
+	return DEFAULT_COLOR_FOREGROUND
+}
+
+// Get text to reset background color.
+func (DefaultColor) Background() string {
+	return DEFAULT_COLOR_BACKGROUND
+}
+
+// Get text to reset underline color.
+// Not in standard; implemented in Kitty, VTE, mintty, and iTerm2.
+func (DefaultColor) Underline() string {
+	return DEFAULT_UNDERLINE_COLOR
+}
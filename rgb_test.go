@@ -0,0 +1,88 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestNewRGB(t *testing.T) {
+	type args struct {
+		r int
+		g int
+		b int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    RGB
+		wantErr bool
+	}{
+		{name: "minus color", args: args{r: -1, g: 0, b: 0}, want: RGB{}, wantErr: true},
+		{name: "0", args: args{r: 0, g: 0, b: 0}, want: RGB{}, wantErr: false},
+		{name: "mixed", args: args{r: 95, g: 135, b: 255}, want: RGB{R: 95, G: 135, B: 255}, wantErr: false},
+		{name: "over", args: args{r: 0, g: 0, b: 256}, want: RGB{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRGB(tt.args.r, tt.args.g, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewRGB() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewRGB() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGB_Sequences(t *testing.T) {
+	tests := []struct {
+		name           string
+		c              RGB
+		wantForeground string
+		wantBackground string
+		wantUnderline  string
+		wantHex        string
+	}{
+		{name: "black", c: RGB{}, wantForeground: "\x1b[38;2;0;0;0m", wantBackground: "\x1b[48;2;0;0;0m", wantUnderline: "\x1b[58;2;0;0;0m", wantHex: "#000000"},
+		{name: "cornflower", c: RGB{R: 95, G: 135, B: 255}, wantForeground: "\x1b[38;2;95;135;255m", wantBackground: "\x1b[48;2;95;135;255m", wantUnderline: "\x1b[58;2;95;135;255m", wantHex: "#5F87FF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Foreground(); got != tt.wantForeground {
+				t.Errorf("RGB.Foreground() = %v, want %v", got, tt.wantForeground)
+			}
+			if got := tt.c.Background(); got != tt.wantBackground {
+				t.Errorf("RGB.Background() = %v, want %v", got, tt.wantBackground)
+			}
+			if got := tt.c.Underline(); got != tt.wantUnderline {
+				t.Errorf("RGB.Underline() = %v, want %v", got, tt.wantUnderline)
+			}
+			if got := tt.c.Hex(); got != tt.wantHex {
+				t.Errorf("RGB.Hex() = %v, want %v", got, tt.wantHex)
+			}
+		})
+	}
+}
+
+func TestColor_Interface(t *testing.T) {
+	tests := []struct {
+		name           string
+		c              Color
+		wantForeground string
+	}{
+		{name: "palette", c: COLOR_RED, wantForeground: "\x1b[38;5;9m"},
+		{name: "rgb", c: RGB{R: 1, G: 2, B: 3}, wantForeground: "\x1b[38;2;1;2;3m"},
+		{name: "default", c: DefaultColor{}, wantForeground: DEFAULT_COLOR_FOREGROUND},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Foreground(tt.c); got != tt.wantForeground {
+				t.Errorf("Foreground() = %v, want %v", got, tt.wantForeground)
+			}
+		})
+	}
+	if Color(RGB{R: 1}) != Color(RGB{R: 1}) {
+		t.Errorf("equal RGB colors must be comparable as Color")
+	}
+}
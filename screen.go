@@ -0,0 +1,118 @@
+package gonsole
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+)
+
+const (
+	ERASE_DISPLAY_TO_END   = "\x1b[0J" // From cursor to the end of screen
+	ERASE_DISPLAY_TO_START = "\x1b[1J" // From cursor to the beginning of screen
+	ERASE_DISPLAY          = "\x1b[2J" // Entire screen, cursor is not moved
+	ERASE_SCROLLBACK       = "\x1b[3J" // Entire screen and scrollback buffer. xterm extension, widely supported
+	ERASE_LINE_TO_END      = "\x1b[0K" // From cursor to the end of line
+	ERASE_LINE_TO_START    = "\x1b[1K" // From cursor to the beginning of line
+	ERASE_LINE             = "\x1b[2K" // Entire line, cursor is not moved
+	RESET_SCROLL_REGION    = "\x1b[r"  // Scroll region is the entire screen
+	ENTER_ALTERNATE_SCREEN = "\x1b[?1049h"
+	EXIT_ALTERNATE_SCREEN  = "\x1b[?1049l"
+)
+
+// Get text to scroll screen content up by n lines, new lines are added at the bottom.
+func ScrollUp(n int) string {
+	return cursorMove(n, 'S')
+}
+
+// Get text to scroll screen content down by n lines, new lines are added at the top.
+func ScrollDown(n int) string {
+	return cursorMove(n, 'T')
+}
+
+// Get text to limit scrolling to lines from top to bottom (DECSTBM). Lines start from 1. Cursor moves to home position.
+func SetScrollRegion(top, bottom int) string {
+	return fmt.Sprintf("\x1b[%d;%dr", atLeastOne(top), atLeastOne(bottom))
+}
+
+// Get text to insert n blank lines at cursor line, lines below are moved down.
+func InsertLines(n int) string {
+	return cursorMove(n, 'L')
+}
+
+// Get text to delete n lines starting from cursor line, lines below are moved up.
+func DeleteLines(n int) string {
+	return cursorMove(n, 'M')
+}
+
+// Get text to insert n blank characters at cursor, characters after cursor are moved right.
+func InsertCharacters(n int) string {
+	return cursorMove(n, '@')
+}
+
+// Get text to delete n characters starting from cursor, characters after cursor are moved left.
+func DeleteCharacters(n int) string {
+	return cursorMove(n, 'P')
+}
+
+// Get text to replace n characters starting from cursor with blanks, without moving other characters.
+func EraseCharacters(n int) string {
+	return cursorMove(n, 'X')
+}
+
+// Used to terminate program with signal after terminal restored, replaced in tests.
+var raiseSignal = func(sig os.Signal) {
+	// Close stopped handling of signal, so it terminates program the same way as without guard
+	if p, err := os.FindProcess(os.Getpid()); err == nil && p.Signal(sig) == nil {
+		return
+	}
+	os.Exit(1)
+}
+
+// ScreenGuard keeps alternate screen buffer active, and restores primary screen and cursor visibility
+// when closed or when program receives SIGINT or SIGTERM.
+type ScreenGuard struct {
+	w       io.Writer
+	once    sync.Once
+	err     error
+	signals chan os.Signal
+	done    chan struct{}
+}
+
+// Enter alternate screen buffer. Close returned guard with defer, so primary screen is restored on return and on panic.
+// On SIGINT or SIGTERM primary screen is restored and the signal is raised again, so program terminates as it would
+// without guard, unless other handler of the signal is registered with signal.Notify. Deferred calls, like Restore
+// of terminal state, are not run then; programs needing them must handle signals themselves and call Close.
+// os.Exit does not run deferred calls either, so Close must be called before it.
+func EnterAlternateScreen(w io.Writer) (*ScreenGuard, error) {
+	g := &ScreenGuard{w: w, signals: make(chan os.Signal, 1), done: make(chan struct{})}
+	if _, err := io.WriteString(w, ENTER_ALTERNATE_SCREEN); err != nil {
+		return nil, err
+	}
+
+	signal.Notify(g.signals, exitSignals...)
+	go g.watch()
+
+	return g, nil
+}
+
+// Restore primary screen and show cursor. Safe to call several times.
+func (g *ScreenGuard) Close() error {
+	g.once.Do(func() {
+		signal.Stop(g.signals)
+		close(g.done)
+		_, g.err = io.WriteString(g.w, SHOW_CURSOR+EXIT_ALTERNATE_SCREEN)
+	})
+	return g.err
+}
+
+// Wait for signal and restore terminal before exit.
+func (g *ScreenGuard) watch() {
+	select {
+	case sig := <-g.signals:
+		g.Close()
+		raiseSignal(sig)
+	case <-g.done:
+	}
+}
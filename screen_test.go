@@ -0,0 +1,107 @@
+package gonsole
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestScreenSequences(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "scroll up", got: ScrollUp(2), want: "\x1b[2S"},
+		{name: "scroll down", got: ScrollDown(3), want: "\x1b[3T"},
+		{name: "scroll zero", got: ScrollDown(0), want: ""},
+		{name: "scroll region", got: SetScrollRegion(2, 20), want: "\x1b[2;20r"},
+		{name: "insert lines", got: InsertLines(1), want: "\x1b[1L"},
+		{name: "delete lines", got: DeleteLines(4), want: "\x1b[4M"},
+		{name: "insert characters", got: InsertCharacters(5), want: "\x1b[5@"},
+		{name: "delete characters", got: DeleteCharacters(6), want: "\x1b[6P"},
+		{name: "erase characters", got: EraseCharacters(7), want: "\x1b[7X"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// Writer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestScreenGuard_Close(t *testing.T) {
+	var out syncBuffer
+	g, err := EnterAlternateScreen(&out)
+	if err != nil {
+		t.Fatalf("EnterAlternateScreen() error = %v", err)
+	}
+	g.Close()
+	g.Close()
+
+	want := ENTER_ALTERNATE_SCREEN + SHOW_CURSOR + EXIT_ALTERNATE_SCREEN
+	if got := out.String(); got != want {
+		t.Errorf("ScreenGuard wrote %q, want %q", got, want)
+	}
+}
+
+func TestScreenGuard_Panic(t *testing.T) {
+	var out syncBuffer
+	func() {
+		defer func() { recover() }()
+		g, _ := EnterAlternateScreen(&out)
+		defer g.Close()
+		panic("test")
+	}()
+
+	want := ENTER_ALTERNATE_SCREEN + SHOW_CURSOR + EXIT_ALTERNATE_SCREEN
+	if got := out.String(); got != want {
+		t.Errorf("ScreenGuard wrote %q, want %q", got, want)
+	}
+}
+
+func TestScreenGuard_Signal(t *testing.T) {
+	raised := make(chan os.Signal, 1)
+	raise := raiseSignal
+	raiseSignal = func(sig os.Signal) { raised <- sig }
+	defer func() { raiseSignal = raise }()
+
+	var out syncBuffer
+	g, _ := EnterAlternateScreen(&out)
+	g.signals <- os.Interrupt
+
+	select {
+	case sig := <-raised:
+		if sig != os.Interrupt {
+			t.Errorf("raised signal = %v, want %v", sig, os.Interrupt)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ScreenGuard did not raise signal")
+	}
+
+	want := ENTER_ALTERNATE_SCREEN + SHOW_CURSOR + EXIT_ALTERNATE_SCREEN
+	if got := out.String(); got != want {
+		t.Errorf("ScreenGuard wrote %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,352 @@
+package gonsole
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SGRState is a set of text attributes produced by SGR sequences, from BOLD to NO_SUPERSCRIPT_NOR_SUBSCRIPT.
+// Zero value is the state after DEFAULT. Nil colors are implementation defined default colors.
+type SGRState struct {
+	Bold                bool
+	Faint               bool
+	Italic              bool
+	Fraktur             bool
+	Underline           UnderlineStyle
+	BlinkingSlow        bool
+	BlinkingRapid       bool
+	Inverted            bool
+	Hidden              bool
+	Crossed             bool
+	Font                int // 0 for primary font, 1-9 for alternate fonts
+	ProportionalSpacing bool
+	Framed              bool
+	Encircled           bool
+	Overlined           bool
+	Ideogram            int // 0 for none, 1-5 for attributes from IDEOGRAM_UNDERLINE_OR_RIGHT_LINE to IDEOGRAM_STRESS_MARKING
+	Superscript         bool
+	Subscript           bool
+	Foreground          Color
+	Background          Color
+	UnderlineColor      Color
+}
+
+// SGRErrorKind is a reason SGR parameter can not be applied.
+type SGRErrorKind int
+
+const (
+	SGR_ERROR_MALFORMED SGRErrorKind = iota // Parameter is not a number, has wrong sub-parameters or color values are missing or out of range
+	SGR_ERROR_UNKNOWN                       // Parameter is well formed, but not known
+)
+
+// SGRError is returned for SGR parameter which can not be applied.
+type SGRError struct {
+	Kind  SGRErrorKind
+	Param string // Parameter with its sub-parameters, like "38;5;300" or "4:9"
+	Index int    // Index of parameter in semicolon separated list
+}
+
+// Get error description.
+func (e *SGRError) Error() string {
+	if e.Kind == SGR_ERROR_UNKNOWN {
+		return fmt.Sprintf("Unknown SGR parameter %q at index %d", e.Param, e.Index)
+	}
+	return fmt.Sprintf("Malformed SGR parameter %q at index %d", e.Param, e.Index)
+}
+
+// Parse SGR parameter list, like "1;38:2::255:0:0", into attributes state starting from DEFAULT.
+func ParseSGR(params string) (SGRState, error) {
+	var s SGRState
+	err := s.Apply(params)
+	return s, err
+}
+
+// Apply SGR parameter list, like "1;38:2::255:0:0", to state. Empty list resets state, as "\x1b[m" does.
+// All valid parameters are applied, and the first invalid one is returned as *SGRError.
+func (s *SGRState) Apply(params string) error {
+	var firstErr error
+	report := func(kind SGRErrorKind, param string, index int) {
+		if firstErr == nil {
+			firstErr = &SGRError{Kind: kind, Param: param, Index: index}
+		}
+	}
+
+	fields := strings.Split(params, ";")
+	for i := 0; i < len(fields); i++ {
+		sub := strings.Split(fields[i], ":")
+		code, ok := sgrNumber(sub[0])
+		if !ok {
+			report(SGR_ERROR_MALFORMED, fields[i], i)
+			continue
+		}
+
+		switch code {
+		case 4:
+			if len(sub) == 1 {
+				s.Underline = UNDERLINE_SINGLE
+			} else if kind, ok := sgrNumber(sub[1]); !ok || len(sub) > 2 {
+				report(SGR_ERROR_MALFORMED, fields[i], i)
+			} else if kind > int(UNDERLINE_DASHED) {
+				report(SGR_ERROR_UNKNOWN, fields[i], i)
+			} else {
+				s.Underline = UnderlineStyle(kind)
+			}
+			continue
+		case 38, 48, 58:
+			var c Color
+			var kind SGRErrorKind
+			param, start := fields[i], i
+			if len(sub) > 1 {
+				c, kind = sgrColor(sub[1:], true)
+			} else {
+				var used int
+				c, used, kind = sgrColorFields(fields[i+1:])
+				i += used
+				param = strings.Join(fields[start:i+1], ";")
+			}
+			if c == nil {
+				report(kind, param, start)
+				continue
+			}
+			switch code {
+			case 38:
+				s.Foreground = c
+			case 48:
+				s.Background = c
+			default:
+				s.UnderlineColor = c
+			}
+			continue
+		}
+
+		if len(sub) > 1 {
+			report(SGR_ERROR_MALFORMED, fields[i], i)
+			continue
+		}
+		if !s.applyCode(code) {
+			report(SGR_ERROR_UNKNOWN, fields[i], i)
+		}
+	}
+
+	return firstErr
+}
+
+// Apply SGR code without sub-parameters. Returns false for unknown code.
+func (s *SGRState) applyCode(code int) bool {
+	switch {
+	case code == 0:
+		*s = SGRState{}
+	case code == 1:
+		s.Bold = true
+	case code == 2:
+		s.Faint = true
+	case code == 3:
+		s.Italic = true
+	case code == 5:
+		s.BlinkingSlow, s.BlinkingRapid = true, false
+	case code == 6:
+		s.BlinkingSlow, s.BlinkingRapid = false, true
+	case code == 7:
+		s.Inverted = true
+	case code == 8:
+		s.Hidden = true
+	case code == 9:
+		s.Crossed = true
+	case code >= 10 && code <= 19:
+		s.Font = code - 10
+	case code == 20:
+		s.Fraktur = true
+	case code == 21:
+		s.Underline = UNDERLINE_DOUBLE
+	case code == 22:
+		s.Bold, s.Faint = false, false
+	case code == 23:
+		s.Italic, s.Fraktur = false, false
+	case code == 24:
+		s.Underline = UNDERLINE_NONE
+	case code == 25:
+		s.BlinkingSlow, s.BlinkingRapid = false, false
+	case code == 26:
+		s.ProportionalSpacing = true
+	case code == 27:
+		s.Inverted = false
+	case code == 28:
+		s.Hidden = false
+	case code == 29:
+		s.Crossed = false
+	case code >= 30 && code <= 37:
+		s.Foreground = PaletteColor(code - 30)
+	case code == 39:
+		s.Foreground = nil
+	case code >= 40 && code <= 47:
+		s.Background = PaletteColor(code - 40)
+	case code == 49:
+		s.Background = nil
+	case code == 50:
+		s.ProportionalSpacing = false
+	case code == 51:
+		s.Framed = true
+	case code == 52:
+		s.Encircled = true
+	case code == 53:
+		s.Overlined = true
+	case code == 54:
+		s.Framed, s.Encircled = false, false
+	case code == 55:
+		s.Overlined = false
+	case code == 59:
+		s.UnderlineColor = nil
+	case code >= 60 && code <= 64:
+		s.Ideogram = code - 59
+	case code == 65:
+		s.Ideogram = 0
+	case code == 73:
+		s.Superscript, s.Subscript = true, false
+	case code == 74:
+		s.Superscript, s.Subscript = false, true
+	case code == 75:
+		s.Superscript, s.Subscript = false, false
+	case code >= 90 && code <= 97:
+		s.Foreground = PaletteColor(code - 90 + 8)
+	case code >= 100 && code <= 107:
+		s.Background = PaletteColor(code - 100 + 8)
+	default:
+		return false
+	}
+	return true
+}
+
+// Get single SGR sequence setting state after DEFAULT. Zero state gives empty string.
+func (s SGRState) Sequence() string {
+	params := make([]string, 0, 8)
+	flags := []struct {
+		on    bool
+		param string
+	}{
+		{s.Bold, "1"}, {s.Faint, "2"}, {s.Italic, "3"}, {s.BlinkingSlow, "5"}, {s.BlinkingRapid, "6"},
+		{s.Inverted, "7"}, {s.Hidden, "8"}, {s.Crossed, "9"}, {s.Fraktur, "20"}, {s.ProportionalSpacing, "26"},
+		{s.Framed, "51"}, {s.Encircled, "52"}, {s.Overlined, "53"}, {s.Superscript, "73"}, {s.Subscript, "74"},
+	}
+	for _, f := range flags {
+		if f.on {
+			params = append(params, f.param)
+		}
+	}
+	if s.Font > 0 {
+		params = append(params, strconv.Itoa(10+s.Font))
+	}
+	if s.Ideogram > 0 {
+		params = append(params, strconv.Itoa(59+s.Ideogram))
+	}
+	switch s.Underline {
+	case UNDERLINE_NONE:
+	case UNDERLINE_SINGLE:
+		params = append(params, "4")
+	default:
+		params = append(params, "4:"+strconv.Itoa(int(s.Underline)))
+	}
+	if s.Foreground != nil {
+		params = append(params, colorParams(s.Foreground, 38, PROFILE_TRUECOLOR))
+	}
+	if s.Background != nil {
+		params = append(params, colorParams(s.Background, 48, PROFILE_TRUECOLOR))
+	}
+	if s.UnderlineColor != nil {
+		params = append(params, colorParams(s.UnderlineColor, 58, PROFILE_TRUECOLOR))
+	}
+
+	return sgr(params)
+}
+
+// Check whether state has no attributes, so text is printed as after DEFAULT.
+func (s SGRState) IsDefault() bool {
+	return s == SGRState{}
+}
+
+// Parse SGR number, empty parameter means 0.
+func sgrNumber(param string) (int, bool) {
+	if param == "" {
+		return 0, true
+	}
+	if strings.Trim(param, "0123456789") != "" || len(param) > 9 {
+		return 0, false
+	}
+	v, err := strconv.Atoi(param)
+	return v, err == nil
+}
+
+// Parse color from semicolon separated fields after 38, 48 or 58.
+// Returns color or error kind, and number of used fields.
+func sgrColorFields(fields []string) (Color, int, SGRErrorKind) {
+	if len(fields) == 0 {
+		return nil, 0, SGR_ERROR_MALFORMED
+	}
+	count := map[string]int{"5": 2, "2": 4, "3": 4, "4": 5, "1": 1, "0": 1}[fields[0]]
+	if count == 0 {
+		return nil, 1, SGR_ERROR_UNKNOWN
+	}
+	if len(fields) < count {
+		return nil, len(fields), SGR_ERROR_MALFORMED
+	}
+	c, kind := sgrColor(fields[:count], false)
+	return c, count, kind
+}
+
+// Parse color from parameters after 38, 48 or 58: 5;n or 2;r;g;b.
+// Colon form may contain color space identifier before RGB values: 2::r:g:b.
+func sgrColor(params []string, colon bool) (Color, SGRErrorKind) {
+	values := make([]int, 0, len(params))
+	for _, p := range params {
+		v, ok := sgrNumber(p)
+		if !ok || v > 255 {
+			return nil, SGR_ERROR_MALFORMED
+		}
+		values = append(values, v)
+	}
+
+	switch values[0] {
+	case 5:
+		if len(values) == 2 {
+			return PaletteColor(values[1]), SGR_ERROR_MALFORMED
+		}
+	case 2:
+		if len(values) == 4 {
+			return RGB{R: uint8(values[1]), G: uint8(values[2]), B: uint8(values[3])}, SGR_ERROR_MALFORMED
+		}
+		if colon && len(values) == 5 {
+			return RGB{R: uint8(values[2]), G: uint8(values[3]), B: uint8(values[4])}, SGR_ERROR_MALFORMED
+		}
+	default:
+		return nil, SGR_ERROR_UNKNOWN
+	}
+	return nil, SGR_ERROR_MALFORMED
+}
+
+// Get colors text is drawn with, swapping foreground and background for inverted text.
+// Default colors are used instead of nil ones only when text is inverted.
+func (s SGRState) colors(foreground, background Color) (Color, Color) {
+	if !s.Inverted {
+		return s.Foreground, s.Background
+	}
+
+	fg, bg := s.Background, s.Foreground
+	if fg == nil {
+		fg = background
+	}
+	if bg == nil {
+		bg = foreground
+	}
+	return fg, bg
+}
+
+// Get RGB value of color. Default and unknown colors are replaced with fallback.
+func colorRGB(c Color, fallback RGB) RGB {
+	switch v := c.(type) {
+	case RGB:
+		return v
+	case PaletteColor:
+		return v.RGB()
+	}
+	return fallback
+}
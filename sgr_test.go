@@ -0,0 +1,79 @@
+package gonsole
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseSGR(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  string
+		want    SGRState
+		wantErr *SGRError
+	}{
+		{name: "empty", params: "", want: SGRState{}},
+		{name: "bold italic", params: "1;3", want: SGRState{Bold: true, Italic: true}},
+		{name: "reset", params: "1;0;2", want: SGRState{Faint: true}},
+		{name: "empty param resets", params: "1;;3", want: SGRState{Italic: true}},
+		{name: "underline kinds", params: "4:3", want: SGRState{Underline: UNDERLINE_CURLY}},
+		{name: "double underline", params: "21", want: SGRState{Underline: UNDERLINE_DOUBLE}},
+		{name: "underline off", params: "4;24", want: SGRState{}},
+		{name: "blinking", params: "5;6", want: SGRState{BlinkingRapid: true}},
+		{name: "fonts", params: "13;20", want: SGRState{Font: 3, Fraktur: true}},
+		{name: "attributes", params: "7;8;9;26;51;52;53;62;73", want: SGRState{Inverted: true, Hidden: true, Crossed: true, ProportionalSpacing: true, Framed: true, Encircled: true, Overlined: true, Ideogram: 3, Superscript: true}},
+		{name: "attributes off", params: "7;8;9;26;51;52;53;62;74;27;28;29;50;54;55;65;75", want: SGRState{}},
+		{name: "standard colors", params: "31;42", want: SGRState{Foreground: COLOR_MAROON, Background: COLOR_OFFICE_GREEN}},
+		{name: "bright colors", params: "91;102", want: SGRState{Foreground: COLOR_RED, Background: COLOR_GREEN}},
+		{name: "palette colors", params: "38;5;196;48;5;21;58;5;1", want: SGRState{Foreground: PaletteColor(196), Background: PaletteColor(21), UnderlineColor: COLOR_MAROON}},
+		{name: "rgb colors", params: "38;2;1;2;3;1", want: SGRState{Foreground: RGB{R: 1, G: 2, B: 3}, Bold: true}},
+		{name: "colon rgb", params: "38:2::1:2:3;48:2:4:5:6", want: SGRState{Foreground: RGB{R: 1, G: 2, B: 3}, Background: RGB{R: 4, G: 5, B: 6}}},
+		{name: "colon palette", params: "58:5:100", want: SGRState{UnderlineColor: PaletteColor(100)}},
+		{name: "default colors", params: "31;41;58;5;1;39;49;59", want: SGRState{}},
+		{name: "unknown code", params: "1;66;3", want: SGRState{Bold: true, Italic: true}, wantErr: &SGRError{Kind: SGR_ERROR_UNKNOWN, Param: "66", Index: 1}},
+		{name: "not a number", params: "1;x", want: SGRState{Bold: true}, wantErr: &SGRError{Kind: SGR_ERROR_MALFORMED, Param: "x", Index: 1}},
+		{name: "color out of range", params: "38;5;300;1", want: SGRState{Bold: true}, wantErr: &SGRError{Kind: SGR_ERROR_MALFORMED, Param: "38;5;300", Index: 0}},
+		{name: "color missing values", params: "48;2;1", want: SGRState{}, wantErr: &SGRError{Kind: SGR_ERROR_MALFORMED, Param: "48;2;1", Index: 0}},
+		{name: "unknown color space", params: "38;3;1;2;3;1", want: SGRState{Bold: true}, wantErr: &SGRError{Kind: SGR_ERROR_UNKNOWN, Param: "38;3;1;2;3", Index: 0}},
+		{name: "unknown underline", params: "4:9", want: SGRState{}, wantErr: &SGRError{Kind: SGR_ERROR_UNKNOWN, Param: "4:9", Index: 0}},
+		{name: "unexpected sub parameter", params: "1:2", want: SGRState{}, wantErr: &SGRError{Kind: SGR_ERROR_MALFORMED, Param: "1:2", Index: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSGR(tt.params)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("ParseSGR() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil {
+				var sgrErr *SGRError
+				if !errors.As(err, &sgrErr) || *sgrErr != *tt.wantErr {
+					t.Errorf("ParseSGR() error = %v, want %v", err, tt.wantErr)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSGR() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSGRState_Sequence(t *testing.T) {
+	tests := []struct {
+		name  string
+		state SGRState
+		want  string
+	}{
+		{name: "default", state: SGRState{}, want: ""},
+		{name: "bold red", state: SGRState{Bold: true, Foreground: PaletteColor(196)}, want: "\x1b[1;38;5;196m"},
+		{name: "curly underline", state: SGRState{Underline: UNDERLINE_CURLY, UnderlineColor: RGB{R: 1}}, want: "\x1b[4:3;58;2;1;0;0m"},
+		{name: "font and ideogram", state: SGRState{Font: 2, Ideogram: 1}, want: "\x1b[12;60m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.state.Sequence(); got != tt.want {
+				t.Errorf("SGRState.Sequence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !plan9
+
+package gonsole
+
+import (
+	"os"
+	"syscall"
+)
+
+// Signals terminating program, after which ScreenGuard restores terminal.
+var exitSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
@@ -0,0 +1,10 @@
+//go:build plan9
+
+package gonsole
+
+import (
+	"os"
+)
+
+// Signals terminating program, after which ScreenGuard restores terminal.
+var exitSignals = []os.Signal{os.Interrupt}
@@ -0,0 +1,193 @@
+package gonsole
+
+import (
+	"strings"
+)
+
+// UnderlineStyle is a kind of underline. Styles other than single and double are implemented in Kitty, VTE, mintty and iTerm2.
+type UnderlineStyle int
+
+const (
+	UNDERLINE_NONE UnderlineStyle = iota
+	UNDERLINE_SINGLE
+	UNDERLINE_DOUBLE
+	UNDERLINE_CURLY
+	UNDERLINE_DOTTED
+	UNDERLINE_DASHED
+)
+
+// Text attributes which are either on or off.
+type styleAttribute int
+
+const (
+	styleBold styleAttribute = 1 << iota
+	styleFaint
+	styleItalic
+	styleBlink
+	styleInverse
+	styleHidden
+	styleStrikethrough
+	styleOverline
+)
+
+// SGR parameters to reset every attribute, in order of rendering.
+var styleAttributes = []struct {
+	attribute styleAttribute
+	reset     string
+}{
+	{attribute: styleBold, reset: "22"},
+	{attribute: styleFaint, reset: "22"},
+	{attribute: styleItalic, reset: "23"},
+	{attribute: styleBlink, reset: "25"},
+	{attribute: styleInverse, reset: "27"},
+	{attribute: styleHidden, reset: "28"},
+	{attribute: styleStrikethrough, reset: "29"},
+	{attribute: styleOverline, reset: "55"},
+}
+
+// Style is an immutable set of text attributes and colors. Every method returns modified copy of style.
+//
+//	title := gonsole.Style{}.Bold().Foreground(gonsole.COLOR_RED)
+//	fmt.Println(title.Render("Error"))
+type Style struct {
+	attributes     styleAttribute
+	underline      UnderlineStyle
+	foreground     Color
+	background     Color
+	underlineColor Color
+}
+
+// Get copy of style with bold text.
+func (s Style) Bold() Style {
+	s.attributes |= styleBold
+	return s
+}
+
+// Get copy of style with faint text.
+func (s Style) Faint() Style {
+	s.attributes |= styleFaint
+	return s
+}
+
+// Get copy of style with italic text.
+func (s Style) Italic() Style {
+	s.attributes |= styleItalic
+	return s
+}
+
+// Get copy of style with passed underline kind. UNDERLINE_NONE removes underline.
+func (s Style) Underline(kind UnderlineStyle) Style {
+	s.underline = kind
+	return s
+}
+
+// Get copy of style with slowly blinking text.
+func (s Style) Blink() Style {
+	s.attributes |= styleBlink
+	return s
+}
+
+// Get copy of style with swapped foreground and background colors.
+func (s Style) Inverse() Style {
+	s.attributes |= styleInverse
+	return s
+}
+
+// Get copy of style with hidden text.
+func (s Style) Hidden() Style {
+	s.attributes |= styleHidden
+	return s
+}
+
+// Get copy of style with crossed out text.
+func (s Style) Strikethrough() Style {
+	s.attributes |= styleStrikethrough
+	return s
+}
+
+// Get copy of style with overlined text. Not supported in Terminal.app
+func (s Style) Overline() Style {
+	s.attributes |= styleOverline
+	return s
+}
+
+// Get copy of style with passed foreground color. Nil removes color.
+func (s Style) Foreground(c Color) Style {
+	s.foreground = c
+	return s
+}
+
+// Get copy of style with passed background color. Nil removes color.
+func (s Style) Background(c Color) Style {
+	s.background = c
+	return s
+}
+
+// Get copy of style with passed underline color. Nil removes color.
+// Not in standard; implemented in Kitty, VTE, mintty, and iTerm2.
+func (s Style) UnderlineColor(c Color) Style {
+	s.underlineColor = c
+	return s
+}
+
+// Get single SGR sequence setting all attributes of style, like "\x1b[1;3;38;5;196m". Empty style gives empty string.
+func (s Style) Sequence() string {
+	return s.state().Sequence()
+}
+
+// Get single SGR sequence resetting only attributes set by style. Empty style gives empty string.
+func (s Style) Reset() string {
+	params := make([]string, 0, 8)
+	for _, a := range styleAttributes {
+		if s.attributes&a.attribute != 0 && (len(params) == 0 || params[len(params)-1] != a.reset) {
+			params = append(params, a.reset)
+		}
+	}
+	if s.underline != UNDERLINE_NONE {
+		params = append(params, "24")
+	}
+	if s.foreground != nil {
+		params = append(params, "39")
+	}
+	if s.background != nil {
+		params = append(params, "49")
+	}
+	if s.underlineColor != nil {
+		params = append(params, "59")
+	}
+
+	return sgr(params)
+}
+
+// Get text wrapped with style sequence and sequence resetting only attributes of style, like "\x1b[1;2mx\x1b[22m",
+// so attributes of surrounding text set before are kept. Attributes sharing reset parameter, like bold and faint,
+// are reset together.
+func (s Style) Render(text string) string {
+	return s.Sequence() + text + s.Reset()
+}
+
+// Get SGR state with attributes of style.
+func (s Style) state() SGRState {
+	return SGRState{
+		Bold:           s.attributes&styleBold != 0,
+		Faint:          s.attributes&styleFaint != 0,
+		Italic:         s.attributes&styleItalic != 0,
+		BlinkingSlow:   s.attributes&styleBlink != 0,
+		Inverted:       s.attributes&styleInverse != 0,
+		Hidden:         s.attributes&styleHidden != 0,
+		Crossed:        s.attributes&styleStrikethrough != 0,
+		Overlined:      s.attributes&styleOverline != 0,
+		Underline:      s.underline,
+		Foreground:     s.foreground,
+		Background:     s.background,
+		UnderlineColor: s.underlineColor,
+	}
+}
+
+// Get SGR sequence with passed parameters, or empty string when there are no parameters.
+func sgr(params []string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
@@ -0,0 +1,60 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestStyle_Sequence(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  string
+	}{
+		{name: "empty", style: Style{}, want: ""},
+		{name: "bold italic red", style: Style{}.Bold().Italic().Foreground(PaletteColor(196)), want: "\x1b[1;3;38;5;196m"},
+		{name: "attributes order", style: Style{}.Overline().Strikethrough().Hidden().Inverse().Blink().Faint(), want: "\x1b[2;5;7;8;9;53m"},
+		{name: "single underline", style: Style{}.Underline(UNDERLINE_SINGLE), want: "\x1b[4m"},
+		{name: "curly underline", style: Style{}.Underline(UNDERLINE_CURLY).UnderlineColor(RGB{R: 255}), want: "\x1b[4:3;58;2;255;0;0m"},
+		{name: "underline removed", style: Style{}.Underline(UNDERLINE_DOUBLE).Underline(UNDERLINE_NONE), want: ""},
+		{name: "background", style: Style{}.Background(RGB{R: 1, G: 2, B: 3}), want: "\x1b[48;2;1;2;3m"},
+		{name: "default colors", style: Style{}.Foreground(DefaultColor{}).Background(DefaultColor{}), want: "\x1b[39;49m"},
+		{name: "color removed", style: Style{}.Foreground(COLOR_RED).Foreground(nil), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.Sequence(); got != tt.want {
+				t.Errorf("Style.Sequence() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyle_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		text  string
+		want  string
+	}{
+		{name: "empty", style: Style{}, text: "text", want: "text"},
+		{name: "bold", style: Style{}.Bold(), text: "text", want: "\x1b[1mtext\x1b[22m"},
+		{name: "bold faint", style: Style{}.Bold().Faint(), text: "text", want: "\x1b[1;2mtext\x1b[22m"},
+		{name: "all", style: Style{}.Bold().Italic().Underline(UNDERLINE_DASHED).Blink().Inverse().Strikethrough().Overline().Foreground(COLOR_RED).Background(COLOR_BLUE).UnderlineColor(COLOR_GREEN), text: "x",
+			want: "\x1b[1;3;5;7;9;53;4:5;38;5;9;48;5;12;58;5;10mx\x1b[22;23;25;27;29;55;24;39;49;59m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.Render(tt.text); got != tt.want {
+				t.Errorf("Style.Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyle_Immutable(t *testing.T) {
+	base := Style{}.Bold()
+	_ = base.Italic().Foreground(COLOR_RED)
+	if got := base.Sequence(); got != BOLD {
+		t.Errorf("Style.Sequence() = %q, want %q", got, BOLD)
+	}
+}
@@ -0,0 +1,148 @@
+package gonsole
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// SVGOptions configures rendering of styled text to SVG terminal screenshot.
+type SVGOptions struct {
+	Columns    int     // Number of terminal columns, longer lines are wrapped. Width of the longest line when 0
+	FontFamily string  // CSS font family, monospace fonts list when empty
+	FontSize   float64 // Font size in pixels, 14 when 0
+	Chrome     bool    // Draw window title bar with buttons
+	Title      string  // Window title, drawn only with Chrome
+	Theme      *Theme  // Terminal colors, DefaultTheme() when nil
+}
+
+// Size of space around text and window title bar, relative to font size.
+const (
+	svgPadding      = 1.0
+	svgChromeHeight = 2.4
+)
+
+// Get standalone SVG screenshot of styled text, like string returned by Demo().
+func SVG(s string) string {
+	return SVGOptions{}.Render(s)
+}
+
+// Get standalone SVG screenshot of styled text with options.
+func (o SVGOptions) Render(s string) string {
+	theme := DefaultTheme()
+	if o.Theme != nil {
+		theme = *o.Theme
+	}
+	fontFamily := o.FontFamily
+	if fontFamily == "" {
+		fontFamily = "'DejaVu Sans Mono', Menlo, Consolas, monospace"
+	}
+	fontSize := o.FontSize
+	if fontSize <= 0 {
+		fontSize = 14
+	}
+
+	lines := layoutGrid(s, o.Columns)
+	columns := o.Columns
+	if columns <= 0 {
+		columns = gridWidth(lines)
+	}
+
+	cellWidth, lineHeight := fontSize*0.6, fontSize*1.2
+	padding, top := fontSize*svgPadding, fontSize*svgPadding
+	if o.Chrome {
+		top += fontSize * svgChromeHeight
+	}
+	width := float64(columns)*cellWidth + 2*padding
+	height := float64(len(lines))*lineHeight + top + padding
+	buttonRadius := fontSize * 0.45
+	if o.Chrome && width < 2*padding+buttonRadius*9 {
+		width = 2*padding + buttonRadius*9
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		svgNumber(width), svgNumber(height), svgNumber(width), svgNumber(height))
+	fmt.Fprintf(&b, `<style>text{font-family:%s;font-size:%spx;white-space:pre}</style>`+"\n", html.EscapeString(fontFamily), svgNumber(fontSize))
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" rx="%s" fill="%s"/>`+"\n", svgNumber(fontSize*0.5), theme.Background.Hex())
+
+	if o.Chrome {
+		for i, c := range []string{"#FF5F56", "#FFBD2E", "#27C93F"} {
+			fmt.Fprintf(&b, `<circle cx="%s" cy="%s" r="%s" fill="%s"/>`+"\n",
+				svgNumber(padding+buttonRadius*(1+3.5*float64(i))), svgNumber(fontSize*1.2), svgNumber(buttonRadius), c)
+		}
+		if o.Title != "" {
+			fmt.Fprintf(&b, `<text x="%s" y="%s" text-anchor="middle" fill="%s" opacity="0.7">%s</text>`+"\n",
+				svgNumber(width/2), svgNumber(fontSize*1.6), theme.Foreground.Hex(), html.EscapeString(o.Title))
+		}
+	}
+
+	fmt.Fprintf(&b, `<g transform="translate(%s %s)">`+"\n", svgNumber(padding), svgNumber(top))
+	for row, line := range lines {
+		y := float64(row) * lineHeight
+		for start := 0; start < len(line); {
+			end := start + 1
+			for end < len(line) && line[end].state == line[start].state {
+				end++
+			}
+			o.run(&b, theme, line[start:end], float64(start)*cellWidth, y, cellWidth, lineHeight)
+			start = end
+		}
+	}
+	b.WriteString("</g>\n</svg>\n")
+
+	return b.String()
+}
+
+// Write background and text of cells with the same attributes.
+func (o SVGOptions) run(b *strings.Builder, theme Theme, cells []gridCell, x, y, cellWidth, lineHeight float64) {
+	state := cells[0].state
+	fg, bg := theme.colors(state)
+	runWidth := float64(len(cells)) * cellWidth
+
+	if bg != theme.Background {
+		fmt.Fprintf(b, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`+"\n",
+			svgNumber(x), svgNumber(y), svgNumber(runWidth), svgNumber(lineHeight), bg.Hex())
+	}
+
+	var text strings.Builder
+	for _, c := range cells {
+		text.WriteString(c.text)
+	}
+	if state.Hidden || strings.TrimSpace(text.String()) == "" && state.Underline == UNDERLINE_NONE && !state.Crossed && !state.Overlined {
+		return
+	}
+
+	attributes := []string{fmt.Sprintf(`fill="%s"`, fg.Hex())}
+	if state.Bold {
+		attributes = append(attributes, `font-weight="bold"`)
+	}
+	if state.Italic {
+		attributes = append(attributes, `font-style="italic"`)
+	}
+	if state.Faint {
+		attributes = append(attributes, `opacity="0.5"`)
+	}
+	var decorations []string
+	if state.Underline != UNDERLINE_NONE {
+		decorations = append(decorations, "underline")
+	}
+	if state.Crossed {
+		decorations = append(decorations, "line-through")
+	}
+	if state.Overlined {
+		decorations = append(decorations, "overline")
+	}
+	if len(decorations) > 0 {
+		attributes = append(attributes, fmt.Sprintf(`text-decoration="%s"`, strings.Join(decorations, " ")))
+	}
+
+	fmt.Fprintf(b, `<text x="%s" y="%s" textLength="%s" lengthAdjust="spacingAndGlyphs" %s>%s</text>`+"\n",
+		svgNumber(x), svgNumber(y+lineHeight*0.8), svgNumber(runWidth), strings.Join(attributes, " "), html.EscapeString(text.String()))
+}
+
+// Format number for SVG attribute with at most two decimal places.
+func svgNumber(v float64) string {
+	return strconv.FormatFloat(float64(int64(v*100+0.5))/100, 'f', -1, 64)
+}
@@ -0,0 +1,97 @@
+package gonsole
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestSVG(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		contains []string
+		excludes []string
+	}{
+		{name: "size", s: "ab\ncd", contains: []string{`width="44.8" height="61.6"`, `<text x="0" y="13.44" textLength="16.8"`, ">ab</text>", ">cd</text>"}},
+		{name: "colors", s: COLOR_RED.Foreground() + COLOR_BLUE.Background() + "x", contains: []string{`fill="#0000FF"/>`, `fill="#FF0000"`}},
+		{name: "inverse", s: INVERTED + "x", contains: []string{`<rect x="0" y="0" width="8.4" height="16.8" fill="#C0C0C0"/>`, `fill="#000000">x</text>`}},
+		{name: "attributes", s: "\x1b[1;3;2;4;9mx", contains: []string{`font-weight="bold" font-style="italic" opacity="0.5" text-decoration="underline line-through"`}},
+		{name: "escaped", s: "<&>", contains: []string{">&lt;&amp;&gt;</text>"}},
+		{name: "hidden", s: HIDE + "secret", excludes: []string{"secret"}},
+		{name: "no chrome", s: "x", excludes: []string{"<circle"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SVG(tt.s)
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("SVG() = %v, want to contain %v", got, want)
+				}
+			}
+			for _, exclude := range tt.excludes {
+				if strings.Contains(got, exclude) {
+					t.Errorf("SVG() = %v, want not to contain %v", got, exclude)
+				}
+			}
+		})
+	}
+}
+
+func TestSVGOptions_Render(t *testing.T) {
+	theme := DefaultTheme()
+	theme.ANSI[COLOR_RED] = RGB{R: 0xEE, G: 0x11, B: 0x22}
+	got := SVGOptions{Columns: 4, FontFamily: "Fira Code", FontSize: 10, Chrome: true, Title: "demo", Theme: &theme}.Render(COLOR_RED.Foreground() + "abcdef")
+
+	for _, want := range []string{
+		`width="60.5" height="68"`,
+		"font-family:Fira Code;font-size:10px",
+		"<circle",
+		">demo</text>",
+		`fill="#EE1122">abcd</text>`,
+		`fill="#EE1122">ef</text>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("SVGOptions.Render() = %v, want to contain %v", got, want)
+		}
+	}
+}
+
+func TestSVG_Demo(t *testing.T) {
+	if err := xml.Unmarshal([]byte(SVG(Demo())), new(struct{})); err != nil {
+		t.Errorf("SVG() of Demo() is not valid XML: %v", err)
+	}
+}
+
+func Test_layoutGrid(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		columns int
+		want    []string
+	}{
+		{name: "lines", s: "ab\ncd", columns: 0, want: []string{"ab", "cd"}},
+		{name: "wrap", s: "abcde", columns: 2, want: []string{"ab", "cd", "e"}},
+		{name: "wide wrap", s: "a日本", columns: 4, want: []string{"a日", "本"}},
+		{name: "tab", s: "a\tb", columns: 0, want: []string{"a       b"}},
+		{name: "carriage return", s: "abcd\rxy", columns: 0, want: []string{"xycd"}},
+		{name: "overwrite wide", s: "日本\rx", columns: 0, want: []string{"x 本"}},
+		{name: "combining", s: "éa", columns: 0, want: []string{"éa"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := layoutGrid(tt.s, tt.columns)
+			var got []string
+			for _, line := range lines {
+				var text strings.Builder
+				for _, c := range line {
+					text.WriteString(c.text)
+				}
+				got = append(got, text.String())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("layoutGrid() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
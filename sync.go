@@ -0,0 +1,74 @@
+package gonsole
+
+import (
+	"io"
+	"time"
+)
+
+const (
+	BEGIN_SYNC = "\x1b[?2026h" // Terminal keeps showing previous frame until END_SYNC
+	END_SYNC   = "\x1b[?2026l" // Terminal shows everything written after BEGIN_SYNC at once
+)
+
+// SyncWriter writes frames with synchronized output (mode 2026), so terminal does not show half-drawn frames.
+// When terminal does not support it, frames are written as is.
+type SyncWriter struct {
+	w         io.Writer
+	supported bool
+}
+
+// Check whether terminal supports synchronized output. Input must be in raw mode.
+// Zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) SupportsSync(w io.Writer, timeout time.Duration) bool {
+	setting, err := d.ReadPrivateMode(w, modeSynchronizedOutput, timeout)
+	return err == nil && setting.Supported()
+}
+
+// Create writer for terminal behind w. Pass result of Decoder.SupportsSync or TerminalInfo.SynchronizedOutput as supported.
+func NewSyncWriter(w io.Writer, supported bool) *SyncWriter {
+	return &SyncWriter{w: w, supported: supported}
+}
+
+// Check whether terminal supports synchronized output.
+func (s *SyncWriter) Supported() bool {
+	return s.supported
+}
+
+// Write text as is.
+func (s *SyncWriter) Write(p []byte) (int, error) {
+	return s.w.Write(p)
+}
+
+// Start frame. Nothing is written when synchronized output is not supported.
+func (s *SyncWriter) BeginSync() error {
+	return s.write(BEGIN_SYNC)
+}
+
+// End frame started with BeginSync. Nothing is written when synchronized output is not supported.
+func (s *SyncWriter) EndSync() error {
+	return s.write(END_SYNC)
+}
+
+// Draw frame with synchronized output. Frame is ended even when draw panics, so terminal does not stay frozen.
+func (s *SyncWriter) Synchronized(draw func()) (err error) {
+	if err := s.BeginSync(); err != nil {
+		return err
+	}
+	defer func() {
+		if endErr := s.EndSync(); err == nil {
+			err = endErr
+		}
+	}()
+
+	draw()
+	return nil
+}
+
+// Write sequence when synchronized output is supported.
+func (s *SyncWriter) write(seq string) error {
+	if !s.supported {
+		return nil
+	}
+	_, err := io.WriteString(s.w, seq)
+	return err
+}
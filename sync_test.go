@@ -0,0 +1,79 @@
+package gonsole
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSyncWriter_Synchronized(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported bool
+		want      string
+	}{
+		{name: "supported", supported: true, want: BEGIN_SYNC + ERASE_DISPLAY + "frame" + END_SYNC},
+		{name: "not supported", supported: false, want: ERASE_DISPLAY + "frame"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			s := NewSyncWriter(&out, tt.supported)
+			err := s.Synchronized(func() {
+				fmt.Fprint(s, ERASE_DISPLAY+"frame")
+			})
+			if err != nil {
+				t.Errorf("SyncWriter.Synchronized() error = %v", err)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("SyncWriter.Synchronized() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncWriter_SynchronizedPanic(t *testing.T) {
+	var out bytes.Buffer
+	s := NewSyncWriter(&out, true)
+	func() {
+		defer func() { recover() }()
+		s.Synchronized(func() {
+			io.WriteString(s, "a")
+			panic("test")
+		})
+	}()
+
+	if got, want := out.String(), BEGIN_SYNC+"a"+END_SYNC; got != want {
+		t.Errorf("SyncWriter.Synchronized() wrote %q, want %q", got, want)
+	}
+}
+
+func TestDecoder_SupportsSync(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply string
+		want  bool
+	}{
+		{name: "reset", reply: "\x1b[?2026;2$y", want: true},
+		{name: "not recognized", reply: "\x1b[?2026;0$y", want: false},
+		{name: "no reply", reply: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w := io.Pipe()
+			defer w.Close()
+			d := NewDecoder(r)
+			go w.Write([]byte(tt.reply))
+
+			var out bytes.Buffer
+			if got := d.SupportsSync(&out, 20*time.Millisecond); got != tt.want {
+				t.Errorf("Decoder.SupportsSync() = %v, want %v", got, tt.want)
+			}
+			if out.String() != "\x1b[?2026$p" {
+				t.Errorf("Decoder.SupportsSync() wrote %q, want %q", out.String(), "\x1b[?2026$p")
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package gonsole
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TerminalColor is a terminal color other than palette entries, set in terminal settings.
+type TerminalColor int
+
+const (
+	TERMINAL_FOREGROUND TerminalColor = 10
+	TERMINAL_BACKGROUND TerminalColor = 11
+	TERMINAL_CURSOR     TerminalColor = 12
+)
+
+const (
+	RESET_PALETTE = "\x1b]104\x1b\\" // Restore all palette colors changed with SetPaletteColor
+	// Restore all palette colors and terminal colors changed with functions in this package
+	RESET_COLORS = RESET_PALETTE + "\x1b]110\x1b\\\x1b]111\x1b\\\x1b]112\x1b\\"
+)
+
+// ColorEvent is a reply to color query. Reply to palette query has zero Terminal and palette Index.
+type ColorEvent struct {
+	Terminal TerminalColor
+	Index    PaletteColor
+	Color    RGB
+}
+
+func (ColorEvent) isEvent() {}
+
+// Get text to ask terminal for its foreground, background or cursor color (OSC 10, 11, 12).
+func QueryColor(c TerminalColor) string {
+	return osc(fmt.Sprintf("%d;?", int(c)))
+}
+
+// Get text to ask terminal for RGB value of palette color (OSC 4).
+func QueryPaletteColor(c PaletteColor) string {
+	return osc(fmt.Sprintf("4;%d;?", int(c)))
+}
+
+// Get text to change terminal foreground, background or cursor color (OSC 10, 11, 12).
+func SetColor(c TerminalColor, rgb RGB) string {
+	return osc(fmt.Sprintf("%d;%s", int(c), colorSpec(rgb)))
+}
+
+// Get text to restore terminal foreground, background or cursor color from settings (OSC 110, 111, 112).
+func ResetColor(c TerminalColor) string {
+	return osc(strconv.Itoa(100 + int(c)))
+}
+
+// Get text to change RGB value of palette color (OSC 4). Text already printed with that color changes too.
+func SetPaletteColor(c PaletteColor, rgb RGB) string {
+	return SetPalette(map[PaletteColor]RGB{c: rgb})
+}
+
+// Get text to change RGB values of several palette colors with single sequence (OSC 4).
+func SetPalette(colors map[PaletteColor]RGB) string {
+	if len(colors) == 0 {
+		return ""
+	}
+
+	indices := make([]int, 0, len(colors))
+	for c := range colors {
+		if c >= 0 && c <= 255 {
+			indices = append(indices, int(c))
+		}
+	}
+	sort.Ints(indices)
+
+	var result strings.Builder
+	result.WriteString("4")
+	for _, i := range indices {
+		fmt.Fprintf(&result, ";%d;%s", i, colorSpec(colors[PaletteColor(i)]))
+	}
+	return osc(result.String())
+}
+
+// Get text to restore palette color from settings (OSC 104).
+func ResetPaletteColor(c PaletteColor) string {
+	return osc(fmt.Sprintf("104;%d", int(c)))
+}
+
+// Ask terminal for its foreground, background or cursor color and wait for reply. Input must be in raw mode.
+// Returns ErrNoReply when terminal does not reply in timeout, zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) ReadColor(w io.Writer, c TerminalColor, timeout time.Duration) (RGB, error) {
+	return d.readColor(w, QueryColor(c), timeout, func(ev ColorEvent) bool {
+		return ev.Terminal == c
+	})
+}
+
+// Ask terminal for RGB value of palette color and wait for reply. Input must be in raw mode.
+// Returns ErrNoReply when terminal does not reply in timeout, zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) ReadPaletteColor(w io.Writer, c PaletteColor, timeout time.Duration) (RGB, error) {
+	return d.readColor(w, QueryPaletteColor(c), timeout, func(ev ColorEvent) bool {
+		return ev.Terminal == 0 && ev.Index == c
+	})
+}
+
+// Check whether terminal background is dark. Terminal is asked for background color first, input must be in raw mode.
+// When terminal does not reply, COLORFGBG variable set by some terminals is used, and without it background is assumed dark.
+func (d *Decoder) IsDarkBackground(w io.Writer, timeout time.Duration) bool {
+	if bg, err := d.ReadColor(w, TERMINAL_BACKGROUND, timeout); err == nil {
+		return bg.Luminance() < darkLuminance
+	}
+	getenv := d.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+	if dark, ok := colorFGBGDark(getenv("COLORFGBG")); ok {
+		return dark
+	}
+	return true
+}
+
+// Relative luminance with equal contrast against black and white, darker colors are dark.
+const darkLuminance = 0.179
+
+// Get relative luminance of color as defined by WCAG, from 0 for black to 1 for white.
+func (c RGB) Luminance() float64 {
+	return 0.2126*linearChannel(c.R) + 0.7152*linearChannel(c.G) + 0.0722*linearChannel(c.B)
+}
+
+// Write color query and wait for matching reply.
+func (d *Decoder) readColor(w io.Writer, query string, timeout time.Duration, match func(ev ColorEvent) bool) (RGB, error) {
+	if _, err := io.WriteString(w, query); err != nil {
+		return RGB{}, err
+	}
+	ev, err := d.waitEvent(timeout, func(ev Event) bool {
+		c, ok := ev.(ColorEvent)
+		return ok && match(c)
+	})
+	if err != nil {
+		return RGB{}, err
+	}
+	return ev.(ColorEvent).Color, nil
+}
+
+// Parse reply to color query without OSC prefix: 4;index;spec, or 10;spec, 11;spec and 12;spec.
+func colorReply(body string) (ColorEvent, bool) {
+	fields := strings.Split(body, ";")
+	var ev ColorEvent
+	switch {
+	case len(fields) == 3 && fields[0] == "4":
+		index, err := strconv.Atoi(fields[1])
+		if err != nil || index < 0 || index > 255 {
+			return ev, false
+		}
+		ev.Index = PaletteColor(index)
+	case len(fields) == 2 && (fields[0] == "10" || fields[0] == "11" || fields[0] == "12"):
+		target, _ := strconv.Atoi(fields[0])
+		ev.Terminal = TerminalColor(target)
+	default:
+		return ev, false
+	}
+
+	c, ok := parseColorSpec(fields[len(fields)-1])
+	ev.Color = c
+	return ev, ok
+}
+
+// Get X11 color specification of color, like rgb:ff/80/00.
+func colorSpec(c RGB) string {
+	return fmt.Sprintf("rgb:%02x/%02x/%02x", c.R, c.G, c.B)
+}
+
+// Parse X11 color specification, like rgb:ffff/8080/0000, rgba:ff/80/00/ff or #ff8000.
+func parseColorSpec(spec string) (RGB, bool) {
+	if strings.HasPrefix(spec, "#") {
+		c, err := ParseHex(spec)
+		return c, err == nil
+	}
+
+	var parts []string
+	switch {
+	case strings.HasPrefix(spec, "rgb:"):
+		parts = strings.Split(spec[4:], "/")
+	case strings.HasPrefix(spec, "rgba:"):
+		parts = strings.Split(spec[5:], "/")
+		if len(parts) == 4 {
+			parts = parts[:3]
+		}
+	}
+	if len(parts) != 3 {
+		return RGB{}, false
+	}
+
+	// Each channel has 1 to 4 hex digits, scaled to 8 bits
+	var channels [3]uint8
+	for i, p := range parts {
+		v, err := strconv.ParseUint(p, 16, 16)
+		if err != nil || len(p) < 1 || len(p) > 4 {
+			return RGB{}, false
+		}
+		scale := uint64(1)<<(4*len(p)) - 1
+		channels[i] = uint8((v*255 + scale/2) / scale)
+	}
+	return RGB{R: channels[0], G: channels[1], B: channels[2]}, true
+}
+
+// Check whether background in COLORFGBG, like "15;0" or "0;default;15", is dark.
+// Palette colors 7 and 9 to 15 are light. Returns false when value can not be parsed.
+func colorFGBGDark(value string) (bool, bool) {
+	fields := strings.Split(value, ";")
+	bg, err := strconv.Atoi(fields[len(fields)-1])
+	if len(fields) < 2 || err != nil || bg < 0 || bg > 15 {
+		return false, false
+	}
+	return bg < 7 || bg == 8, true
+}
@@ -0,0 +1,176 @@
+package gonsole
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestColorQueries(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "foreground", got: QueryColor(TERMINAL_FOREGROUND), want: "\x1b]10;?\x1b\\"},
+		{name: "background", got: QueryColor(TERMINAL_BACKGROUND), want: "\x1b]11;?\x1b\\"},
+		{name: "palette", got: QueryPaletteColor(COLOR_MAROON), want: "\x1b]4;1;?\x1b\\"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeColor(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+	}{
+		{name: "background", in: "\x1b]11;rgb:ffff/8080/0000\x1b\\", want: ColorEvent{Terminal: TERMINAL_BACKGROUND, Color: RGB{R: 255, G: 128, B: 0}}},
+		{name: "foreground bell", in: "\x1b]10;rgb:c0/c0/c0\x07", want: ColorEvent{Terminal: TERMINAL_FOREGROUND, Color: RGB{R: 192, G: 192, B: 192}}},
+		{name: "short channels", in: "\x1b]12;rgb:f/8/0\x07", want: ColorEvent{Terminal: TERMINAL_CURSOR, Color: RGB{R: 255, G: 136, B: 0}}},
+		{name: "rgba", in: "\x1b]11;rgba:0000/0000/ffff/ffff\x07", want: ColorEvent{Terminal: TERMINAL_BACKGROUND, Color: RGB{B: 255}}},
+		{name: "hex", in: "\x1b]11;#102030\x07", want: ColorEvent{Terminal: TERMINAL_BACKGROUND, Color: RGB{R: 16, G: 32, B: 48}}},
+		{name: "palette", in: "\x1b]4;196;rgb:ffff/0000/0000\x07", want: ColorEvent{Index: 196, Color: RGB{R: 255}}},
+		{name: "invalid spec", in: "\x1b]11;rgb:xx/00/00\x07", want: UnknownEvent{Sequence: "\x1b]11;rgb:xx/00/00\x07"}},
+		{name: "invalid index", in: "\x1b]4;300;rgb:0/0/0\x07", want: UnknownEvent{Sequence: "\x1b]4;300;rgb:0/0/0\x07"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := decodeEvent([]byte(tt.in), true); got != tt.want {
+				t.Errorf("decodeEvent(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecoder_ReadColor(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	go w.Write([]byte("\x1b]4;1;rgb:8080/0000/0000\x1b\\\x1b]11;rgb:0000/0000/0000\x1b\\"))
+
+	var out bytes.Buffer
+	got, err := d.ReadColor(&out, TERMINAL_BACKGROUND, time.Second)
+	if err != nil || got != (RGB{}) {
+		t.Errorf("Decoder.ReadColor() = %v, %v, want %v, nil", got, err, RGB{})
+	}
+	if out.String() != QueryColor(TERMINAL_BACKGROUND) {
+		t.Errorf("Decoder.ReadColor() wrote %q, want %q", out.String(), QueryColor(TERMINAL_BACKGROUND))
+	}
+
+	// Palette reply read while waiting is kept
+	if ev, _ := d.ReadEvent(); ev != (ColorEvent{Index: 1, Color: RGB{R: 128}}) {
+		t.Errorf("ReadEvent() = %#v, want palette reply", ev)
+	}
+}
+
+func TestDecoder_ReadPaletteColor(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	go w.Write([]byte("\x1b]4;2;rgb:0000/8080/0000\x1b\\\x1b]4;1;rgb:8080/0000/0000\x1b\\"))
+
+	got, err := d.ReadPaletteColor(io.Discard, COLOR_MAROON, time.Second)
+	if err != nil || got != (RGB{R: 128}) {
+		t.Errorf("Decoder.ReadPaletteColor() = %v, %v, want %v, nil", got, err, RGB{R: 128})
+	}
+}
+
+func TestDecoder_IsDarkBackground(t *testing.T) {
+	tests := []struct {
+		name      string
+		reply     string
+		colorfgbg string
+		want      bool
+	}{
+		{name: "black", reply: "\x1b]11;rgb:0000/0000/0000\x07", want: true},
+		{name: "white", reply: "\x1b]11;rgb:ffff/ffff/ffff\x07", colorfgbg: "15;0", want: false},
+		{name: "solarized light", reply: "\x1b]11;rgb:fdfd/f6f6/e3e3\x07", want: false},
+		{name: "gray", reply: "\x1b]11;rgb:8080/8080/8080\x07", want: false},
+		{name: "fallback light", colorfgbg: "0;default;15", want: false},
+		{name: "fallback dark", colorfgbg: "15;0", want: true},
+		{name: "no information", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w := io.Pipe()
+			defer w.Close()
+			d := NewDecoder(r)
+			d.Getenv = func(key string) string {
+				if key == "COLORFGBG" {
+					return tt.colorfgbg
+				}
+				return ""
+			}
+			go w.Write([]byte(tt.reply))
+
+			if got := d.IsDarkBackground(io.Discard, 20*time.Millisecond); got != tt.want {
+				t.Errorf("Decoder.IsDarkBackground() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGB_Luminance(t *testing.T) {
+	tests := []struct {
+		name string
+		c    RGB
+		want float64
+	}{
+		{name: "black", c: RGB{}, want: 0},
+		{name: "white", c: RGB{R: 255, G: 255, B: 255}, want: 1},
+		{name: "green", c: RGB{G: 255}, want: 0.7152},
+		{name: "gray", c: RGB{R: 128, G: 128, B: 128}, want: 0.2158605},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Luminance(); got-tt.want > 1e-6 || tt.want-got > 1e-6 {
+				t.Errorf("RGB.Luminance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetColors(t *testing.T) {
+	orange := RGB{R: 255, G: 128, B: 0}
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "foreground", got: SetColor(TERMINAL_FOREGROUND, orange), want: "\x1b]10;rgb:ff/80/00\x1b\\"},
+		{name: "cursor", got: SetColor(TERMINAL_CURSOR, RGB{B: 10}), want: "\x1b]12;rgb:00/00/0a\x1b\\"},
+		{name: "reset background", got: ResetColor(TERMINAL_BACKGROUND), want: "\x1b]111\x1b\\"},
+		{name: "palette color", got: SetPaletteColor(COLOR_RED, orange), want: "\x1b]4;9;rgb:ff/80/00\x1b\\"},
+		{name: "palette", got: SetPalette(map[PaletteColor]RGB{200: {}, 3: orange, 300: {}}), want: "\x1b]4;3;rgb:ff/80/00;200;rgb:00/00/00\x1b\\"},
+		{name: "empty palette", got: SetPalette(nil), want: ""},
+		{name: "reset palette color", got: ResetPaletteColor(COLOR_RED), want: "\x1b]104;9\x1b\\"},
+		{name: "reset all", got: RESET_COLORS, want: "\x1b]104\x1b\\\x1b]110\x1b\\\x1b]111\x1b\\\x1b]112\x1b\\"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTheme_Sequence(t *testing.T) {
+	theme := DefaultTheme()
+	got := theme.Sequence()
+	want := "\x1b]10;rgb:c0/c0/c0\x1b\\\x1b]11;rgb:00/00/00\x1b\\\x1b]4;0;rgb:00/00/00;1;rgb:80/00/00;"
+	if len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("Theme.Sequence() = %.80q, want prefix %q", got, want)
+	}
+	if want := ";15;rgb:ff/ff/ff\x1b\\"; got[len(got)-len(want):] != want {
+		t.Errorf("Theme.Sequence() = %q, want suffix %q", got, want)
+	}
+}
@@ -0,0 +1,17 @@
+package gonsole
+
+// TerminalState is a terminal mode saved by MakeRaw or MakeCbreak, used to restore it with Restore.
+type TerminalState struct {
+	termios termios
+}
+
+// TerminalSize is a terminal size in character cells.
+type TerminalSize struct {
+	Columns int
+	Rows    int
+}
+
+// Check whether file descriptor is a terminal.
+func IsTerminal(fd uintptr) bool {
+	return isTerminal(fd)
+}
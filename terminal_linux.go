@@ -0,0 +1,127 @@
+//go:build linux
+
+package gonsole
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+type termios = syscall.Termios
+
+// Error of Restore called with nil state, usually because saving terminal mode failed before.
+var errNoTerminalState = errors.New("Terminal state to restore is nil")
+
+// Get current terminal mode, to restore it later with Restore.
+func GetState(fd uintptr) (*TerminalState, error) {
+	state := &TerminalState{}
+	if err := ioctl(fd, syscall.TCGETS, unsafe.Pointer(&state.termios)); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// Put terminal into raw mode: input is available byte by byte without echo, signals are not generated
+// by control keys and output is not post-processed. Returns previous mode to pass to Restore.
+func MakeRaw(fd uintptr) (*TerminalState, error) {
+	return setMode(fd, func(t *termios) {
+		t.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+		t.Oflag &^= syscall.OPOST
+		t.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+		t.Cflag &^= syscall.CSIZE | syscall.PARENB
+		t.Cflag |= syscall.CS8
+	})
+}
+
+// Put terminal into cbreak mode: input is available byte by byte without echo, but Ctrl+C and other
+// control keys still generate signals and output is processed as usual. Returns previous mode to pass to Restore.
+func MakeCbreak(fd uintptr) (*TerminalState, error) {
+	return setMode(fd, func(t *termios) {
+		t.Lflag &^= syscall.ECHO | syscall.ICANON
+	})
+}
+
+// Restore terminal mode saved by GetState, MakeRaw or MakeCbreak.
+func Restore(fd uintptr, state *TerminalState) error {
+	if state == nil {
+		return errNoTerminalState
+	}
+	return ioctl(fd, syscall.TCSETS, unsafe.Pointer(&state.termios))
+}
+
+// Get terminal size.
+func GetTerminalSize(fd uintptr) (TerminalSize, error) {
+	var ws struct {
+		rows, columns, xpixel, ypixel uint16
+	}
+	if err := ioctl(fd, syscall.TIOCGWINSZ, unsafe.Pointer(&ws)); err != nil {
+		return TerminalSize{}, err
+	}
+	return TerminalSize{Columns: int(ws.columns), Rows: int(ws.rows)}, nil
+}
+
+// Watch terminal resizes. New size is sent to returned channel on every SIGWINCH. When receiver is slow,
+// only the latest size is kept. Call returned function to stop watching, it closes the channel.
+func WatchResize(fd uintptr) (<-chan TerminalSize, func()) {
+	sizes := make(chan TerminalSize, 1)
+	signals := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(signals, syscall.SIGWINCH)
+
+	go func() {
+		defer close(sizes)
+		for {
+			select {
+			case <-signals:
+				size, err := GetTerminalSize(fd)
+				if err != nil {
+					continue
+				}
+				select {
+				case <-sizes:
+				default:
+				}
+				sizes <- size
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return sizes, func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			close(done)
+		})
+	}
+}
+
+// Change terminal mode with function, setting input to return after every byte. Returns previous mode.
+func setMode(fd uintptr, change func(t *termios)) (*TerminalState, error) {
+	state, err := GetState(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	t := state.termios
+	change(&t)
+	t.Cc[syscall.VMIN] = 1
+	t.Cc[syscall.VTIME] = 0
+	if err := ioctl(fd, syscall.TCSETS, unsafe.Pointer(&t)); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// Call ioctl with pointer argument.
+func ioctl(fd uintptr, request uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
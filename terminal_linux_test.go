@@ -0,0 +1,166 @@
+//go:build linux
+
+package gonsole
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+// Open pseudo-terminal pair, returns master and slave ends.
+func openPTY(t *testing.T) (*os.File, *os.File) {
+	t.Helper()
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("pseudo-terminal is not available: %v", err)
+	}
+	t.Cleanup(func() { master.Close() })
+
+	var unlock int32
+	if err := ioctl(master.Fd(), syscall.TIOCSPTLCK, unsafe.Pointer(&unlock)); err != nil {
+		t.Fatalf("unlockpt error = %v", err)
+	}
+	var n uint32
+	if err := ioctl(master.Fd(), syscall.TIOCGPTN, unsafe.Pointer(&n)); err != nil {
+		t.Fatalf("ptsname error = %v", err)
+	}
+	slave, err := os.OpenFile("/dev/pts/"+strconv.Itoa(int(n)), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		t.Skipf("pseudo-terminal slave is not available: %v", err)
+	}
+	t.Cleanup(func() { slave.Close() })
+	return master, slave
+}
+
+func TestIsTerminal(t *testing.T) {
+	master, slave := openPTY(t)
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	tests := []struct {
+		name string
+		fd   uintptr
+		want bool
+	}{
+		{name: "master", fd: master.Fd(), want: true},
+		{name: "slave", fd: slave.Fd(), want: true},
+		{name: "pipe", fd: r.Fd(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTerminal(tt.fd); got != tt.want {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeRaw(t *testing.T) {
+	tests := []struct {
+		name     string
+		make     func(fd uintptr) (*TerminalState, error)
+		cleared  uint32
+		retained uint32
+	}{
+		{name: "raw", make: MakeRaw, cleared: syscall.ECHO | syscall.ICANON | syscall.ISIG | syscall.IEXTEN},
+		{name: "cbreak", make: MakeCbreak, cleared: syscall.ECHO | syscall.ICANON, retained: syscall.ISIG},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, slave := openPTY(t)
+			fd := slave.Fd()
+
+			before, err := tt.make(fd)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			current, _ := GetState(fd)
+			if current.termios.Lflag&tt.cleared != 0 {
+				t.Errorf("local flags = %#x, want %#x cleared", current.termios.Lflag, tt.cleared)
+			}
+			if current.termios.Lflag&tt.retained != tt.retained {
+				t.Errorf("local flags = %#x, want %#x set", current.termios.Lflag, tt.retained)
+			}
+			if current.termios.Cc[syscall.VMIN] != 1 {
+				t.Errorf("VMIN = %v, want 1", current.termios.Cc[syscall.VMIN])
+			}
+
+			if err := Restore(fd, before); err != nil {
+				t.Fatalf("Restore() error = %v", err)
+			}
+			restored, _ := GetState(fd)
+			if restored.termios.Lflag != before.termios.Lflag || restored.termios.Iflag != before.termios.Iflag {
+				t.Errorf("Restore() flags = %#x %#x, want %#x %#x", restored.termios.Lflag, restored.termios.Iflag, before.termios.Lflag, before.termios.Iflag)
+			}
+		})
+	}
+}
+
+// Set pseudo-terminal size through master end.
+func setPTYSize(t *testing.T, master *os.File, size TerminalSize) {
+	t.Helper()
+	ws := struct {
+		rows, columns, xpixel, ypixel uint16
+	}{rows: uint16(size.Rows), columns: uint16(size.Columns)}
+	if err := ioctl(master.Fd(), syscall.TIOCSWINSZ, unsafe.Pointer(&ws)); err != nil {
+		t.Fatalf("TIOCSWINSZ error = %v", err)
+	}
+}
+
+func TestRestore_Nil(t *testing.T) {
+	_, slave := openPTY(t)
+	if err := Restore(slave.Fd(), nil); err == nil {
+		t.Error("Restore() with nil state error = nil, want error")
+	}
+}
+
+func TestGetTerminalSize(t *testing.T) {
+	master, slave := openPTY(t)
+	want := TerminalSize{Columns: 120, Rows: 40}
+	setPTYSize(t, master, want)
+
+	got, err := GetTerminalSize(slave.Fd())
+	if err != nil {
+		t.Fatalf("GetTerminalSize() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("GetTerminalSize() = %v, want %v", got, want)
+	}
+}
+
+func TestWatchResize(t *testing.T) {
+	master, slave := openPTY(t)
+	sizes, stop := WatchResize(slave.Fd())
+
+	want := TerminalSize{Columns: 100, Rows: 30}
+	setPTYSize(t, master, want)
+	syscall.Kill(os.Getpid(), syscall.SIGWINCH)
+
+	select {
+	case got := <-sizes:
+		if got != want {
+			t.Errorf("WatchResize() sent %v, want %v", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WatchResize() did not send size")
+	}
+
+	stop()
+	stop()
+	select {
+	case _, ok := <-sizes:
+		if ok {
+			t.Error("WatchResize() channel is not closed after stop")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WatchResize() channel is not closed after stop")
+	}
+}
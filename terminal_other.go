@@ -0,0 +1,47 @@
+//go:build !linux
+
+package gonsole
+
+import (
+	"errors"
+	"sync"
+)
+
+type termios struct{}
+
+// Error of terminal control functions, which are implemented only on Linux.
+var errTerminalNotSupported = errors.New("Terminal control is not supported on this platform")
+
+// Get current terminal mode. Not supported on this platform.
+func GetState(fd uintptr) (*TerminalState, error) {
+	return nil, errTerminalNotSupported
+}
+
+// Put terminal into raw mode. Not supported on this platform.
+func MakeRaw(fd uintptr) (*TerminalState, error) {
+	return nil, errTerminalNotSupported
+}
+
+// Put terminal into cbreak mode. Not supported on this platform.
+func MakeCbreak(fd uintptr) (*TerminalState, error) {
+	return nil, errTerminalNotSupported
+}
+
+// Restore terminal mode. Not supported on this platform.
+func Restore(fd uintptr, state *TerminalState) error {
+	return errTerminalNotSupported
+}
+
+// Get terminal size. Not supported on this platform.
+func GetTerminalSize(fd uintptr) (TerminalSize, error) {
+	return TerminalSize{}, errTerminalNotSupported
+}
+
+// Watch terminal resizes. Not supported on this platform, returned channel is closed when watching stops.
+func WatchResize(fd uintptr) (<-chan TerminalSize, func()) {
+	sizes := make(chan TerminalSize)
+	var once sync.Once
+	return sizes, func() {
+		once.Do(func() { close(sizes) })
+	}
+}
@@ -0,0 +1,235 @@
+package gonsole
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	QUERY_PRIMARY_DEVICE_ATTRIBUTES   = "\x1b[c"   // DA1, replied by almost all terminals
+	QUERY_SECONDARY_DEVICE_ATTRIBUTES = "\x1b[>c"  // DA2, terminal type and firmware version
+	QUERY_TERMINAL_VERSION            = "\x1b[>0q" // XTVERSION, terminal name and version
+	QUERY_KITTY_KEYBOARD              = "\x1b[?u"  // Current flags of kitty keyboard protocol
+)
+
+// Private modes checked by QueryTerminal.
+const (
+	modeFocusReporting     = 1004
+	modeSGRMouse           = 1006
+	modeBracketedPaste     = 2004
+	modeSynchronizedOutput = 2026
+)
+
+// ModeSetting is a state of terminal mode reported by DECRQM.
+type ModeSetting int
+
+const (
+	MODE_NOT_RECOGNIZED ModeSetting = iota
+	MODE_SET
+	MODE_RESET
+	MODE_PERMANENTLY_SET
+	MODE_PERMANENTLY_RESET
+)
+
+// PrimaryDeviceAttributesEvent is a reply to QUERY_PRIMARY_DEVICE_ATTRIBUTES.
+type PrimaryDeviceAttributesEvent struct {
+	Level      int   // Conformance level: 1 for VT100, 2 for VT220 and so on
+	Attributes []int // Supported features, like 4 for sixel graphics
+}
+
+// SecondaryDeviceAttributesEvent is a reply to QUERY_SECONDARY_DEVICE_ATTRIBUTES.
+type SecondaryDeviceAttributesEvent struct {
+	Type     int // Emulated terminal, like 1 for VT220 and 41 for VT420
+	Firmware int // Usually version of terminal
+}
+
+// TerminalVersionEvent is a reply to QUERY_TERMINAL_VERSION, like "XTerm(390)" or "tmux 3.4".
+type TerminalVersionEvent struct {
+	Version string
+}
+
+// ModeReportEvent is a reply to mode query (DECRQM).
+type ModeReportEvent struct {
+	Mode    int
+	Private bool
+	Setting ModeSetting
+}
+
+// KittyKeyboardEvent is a reply to QUERY_KITTY_KEYBOARD.
+type KittyKeyboardEvent struct {
+	Flags KittyKeyboardFlags
+}
+
+func (PrimaryDeviceAttributesEvent) isEvent()   {}
+func (SecondaryDeviceAttributesEvent) isEvent() {}
+func (TerminalVersionEvent) isEvent()           {}
+func (ModeReportEvent) isEvent()                {}
+func (KittyKeyboardEvent) isEvent()             {}
+
+// TerminalInfo is a set of terminal features found by QueryTerminal.
+type TerminalInfo struct {
+	Name               string // Terminal name, like "XTerm", "kitty" or "tmux". Empty when terminal does not reply to XTVERSION
+	Version            string // Terminal version, like "390" or "0.35.2"
+	Level              int    // Conformance level: 1 for VT100, 2 for VT220 and so on
+	Attributes         []int  // Attributes from DA1 reply
+	Type               int    // Terminal type from DA2 reply
+	Firmware           int    // Firmware version from DA2 reply
+	Sixel              bool   // Sixel graphics
+	Clipboard          bool   // Setting clipboard with OSC 52, reported by xterm only
+	KittyKeyboard      bool   // Kitty keyboard protocol
+	SynchronizedOutput bool   // Synchronized output mode 2026
+	BracketedPaste     bool
+	FocusReporting     bool
+	SGRMouse           bool // SGR mouse encoding, which has no limit on coordinates
+}
+
+// Check whether mode can be set.
+func (s ModeSetting) Supported() bool {
+	return s == MODE_SET || s == MODE_RESET || s == MODE_PERMANENTLY_SET
+}
+
+// Get text to ask terminal for state of private (DEC) mode, like 2026 for synchronized output (DECRQM).
+func QueryPrivateMode(mode int) string {
+	return fmt.Sprintf("\x1b[?%d$p", mode)
+}
+
+// Ask terminal for state of private mode and wait for reply. Input must be in raw mode.
+// Returns ErrNoReply when terminal does not reply in timeout, zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) ReadPrivateMode(w io.Writer, mode int, timeout time.Duration) (ModeSetting, error) {
+	if _, err := io.WriteString(w, QueryPrivateMode(mode)); err != nil {
+		return MODE_NOT_RECOGNIZED, err
+	}
+	ev, err := d.waitEvent(timeout, func(ev Event) bool {
+		report, ok := ev.(ModeReportEvent)
+		return ok && report.Private && report.Mode == mode
+	})
+	if err != nil {
+		return MODE_NOT_RECOGNIZED, err
+	}
+	return ev.(ModeReportEvent).Setting, nil
+}
+
+// Find terminal name, version and supported features. Input must be in raw mode.
+// All queries are sent at once, followed by DA1 query, which almost all terminals reply to, and replies come in order.
+// So only terminals not replying to DA1 take whole timeout, then ErrNoReply and features found so far are returned.
+// Zero timeout means DEFAULT_QUERY_TIMEOUT.
+func (d *Decoder) QueryTerminal(w io.Writer, timeout time.Duration) (TerminalInfo, error) {
+	var info TerminalInfo
+	queries := QUERY_TERMINAL_VERSION + QUERY_SECONDARY_DEVICE_ATTRIBUTES + QUERY_KITTY_KEYBOARD
+	for _, mode := range []int{modeSynchronizedOutput, modeBracketedPaste, modeFocusReporting, modeSGRMouse} {
+		queries += QueryPrivateMode(mode)
+	}
+	if _, err := io.WriteString(w, queries+QUERY_PRIMARY_DEVICE_ATTRIBUTES); err != nil {
+		return info, err
+	}
+
+	timer := queryTimer(timeout)
+	defer timer.Stop()
+	for {
+		ev, err := d.waitUntil(timer.C, isTerminalReply)
+		if err != nil {
+			return info, err
+		}
+		if info.apply(ev) {
+			return info, nil
+		}
+	}
+}
+
+// Check whether event is reply to one of QueryTerminal queries.
+func isTerminalReply(ev Event) bool {
+	switch ev := ev.(type) {
+	case PrimaryDeviceAttributesEvent, SecondaryDeviceAttributesEvent, TerminalVersionEvent, KittyKeyboardEvent:
+		return true
+	case ModeReportEvent:
+		switch ev.Mode {
+		case modeSynchronizedOutput, modeBracketedPaste, modeFocusReporting, modeSGRMouse:
+			return ev.Private
+		}
+	}
+	return false
+}
+
+// Fill terminal information from reply. Returns true for DA1 reply, which is the last one.
+func (info *TerminalInfo) apply(ev Event) bool {
+	switch ev := ev.(type) {
+	case TerminalVersionEvent:
+		info.Name, info.Version = splitTerminalVersion(ev.Version)
+	case SecondaryDeviceAttributesEvent:
+		info.Type, info.Firmware = ev.Type, ev.Firmware
+	case KittyKeyboardEvent:
+		info.KittyKeyboard = true
+	case ModeReportEvent:
+		supported := ev.Setting.Supported()
+		switch ev.Mode {
+		case modeSynchronizedOutput:
+			info.SynchronizedOutput = supported
+		case modeBracketedPaste:
+			info.BracketedPaste = supported
+		case modeFocusReporting:
+			info.FocusReporting = supported
+		case modeSGRMouse:
+			info.SGRMouse = supported
+		}
+	case PrimaryDeviceAttributesEvent:
+		info.Level, info.Attributes = ev.Level, ev.Attributes
+		for _, a := range ev.Attributes {
+			switch a {
+			case 4:
+				info.Sixel = true
+			case 52:
+				info.Clipboard = true
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Split XTVERSION reply, like "XTerm(390)" or "WezTerm 20240203", into name and version.
+func splitTerminalVersion(s string) (string, string) {
+	if i := strings.IndexByte(s, '('); i > 0 && strings.HasSuffix(s, ")") {
+		return s[:i], s[i+1 : len(s)-1]
+	}
+	if i := strings.IndexByte(s, ' '); i > 0 {
+		return s[:i], strings.TrimSpace(s[i+1:])
+	}
+	return s, ""
+}
+
+// Decode reply to terminal query sent as CSI sequence.
+func csiReply(private byte, params [][]int, intermediate string, final byte) (Event, bool) {
+	switch {
+	case private == '?' && intermediate == "" && final == 'c' && len(params) > 0:
+		ev := PrimaryDeviceAttributesEvent{Level: 1}
+		if level := csiParam(params, 0, 0, 0); level > 60 {
+			ev.Level = level - 60
+		}
+		for _, p := range params[1:] {
+			ev.Attributes = append(ev.Attributes, p[0])
+		}
+		return ev, true
+	case private == '>' && intermediate == "" && final == 'c':
+		return SecondaryDeviceAttributesEvent{Type: csiParam(params, 0, 0, 0), Firmware: csiParam(params, 1, 0, 0)}, true
+	case (private == '?' || private == 0) && intermediate == "$" && final == 'y' && len(params) == 2:
+		setting := ModeSetting(csiParam(params, 1, 0, 0))
+		if setting > MODE_PERMANENTLY_RESET {
+			setting = MODE_NOT_RECOGNIZED
+		}
+		return ModeReportEvent{Mode: csiParam(params, 0, 0, 0), Private: private == '?', Setting: setting}, true
+	case private == '?' && intermediate == "" && final == 'u':
+		return KittyKeyboardEvent{Flags: KittyKeyboardFlags(csiParam(params, 0, 0, 0))}, true
+	}
+	return nil, false
+}
+
+// Decode DCS sequence, which terminal sends as reply to a query.
+func decodeDCS(seq []byte) Event {
+	body := strings.TrimSuffix(string(seq[2:]), "\x1b\\")
+	if strings.HasPrefix(body, ">|") {
+		return TerminalVersionEvent{Version: body[2:]}
+	}
+	return UnknownEvent{Sequence: string(seq)}
+}
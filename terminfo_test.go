@@ -0,0 +1,145 @@
+package gonsole
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeTerminalReply(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want Event
+	}{
+		{name: "da1", in: "\x1b[?64;1;4;22;52c", want: PrimaryDeviceAttributesEvent{Level: 4, Attributes: []int{1, 4, 22, 52}}},
+		{name: "da1 vt100", in: "\x1b[?1;2c", want: PrimaryDeviceAttributesEvent{Level: 1, Attributes: []int{2}}},
+		{name: "da2", in: "\x1b[>41;390;0c", want: SecondaryDeviceAttributesEvent{Type: 41, Firmware: 390}},
+		{name: "xtversion", in: "\x1bP>|XTerm(390)\x1b\\", want: TerminalVersionEvent{Version: "XTerm(390)"}},
+		{name: "private mode", in: "\x1b[?2026;2$y", want: ModeReportEvent{Mode: 2026, Private: true, Setting: MODE_RESET}},
+		{name: "ansi mode", in: "\x1b[4;1$y", want: ModeReportEvent{Mode: 4, Setting: MODE_SET}},
+		{name: "invalid mode setting", in: "\x1b[?1;9$y", want: ModeReportEvent{Mode: 1, Private: true}},
+		{name: "kitty keyboard", in: "\x1b[?3u", want: KittyKeyboardEvent{Flags: KITTY_DISAMBIGUATE | KITTY_REPORT_EVENTS}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := decodeEvent([]byte(tt.in), true)
+			if !reflect.DeepEqual(got, tt.want) || n != len(tt.in) {
+				t.Errorf("decodeEvent(%q) = %#v, %v, want %#v, %v", tt.in, got, n, tt.want, len(tt.in))
+			}
+		})
+	}
+}
+
+func TestDecoder_SlowTerminalVersion(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	d.Timeout = 10 * time.Millisecond
+
+	go func() {
+		// Reply string split across slow writes is not cut by escape timeout
+		w.Write([]byte("\x1bP>|XTerm("))
+		time.Sleep(30 * time.Millisecond)
+		w.Write([]byte("390)\x1b\\"))
+	}()
+
+	got, err := d.ReadEvent()
+	if err != nil {
+		t.Fatalf("ReadEvent() error = %v", err)
+	}
+	if want := (TerminalVersionEvent{Version: "XTerm(390)"}); got != want {
+		t.Errorf("ReadEvent() = %#v, want %#v", got, want)
+	}
+}
+
+func TestSplitTerminalVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		name    string
+		version string
+	}{
+		{in: "XTerm(390)", name: "XTerm", version: "390"},
+		{in: "kitty(0.35.2)", name: "kitty", version: "0.35.2"},
+		{in: "tmux 3.4", name: "tmux", version: "3.4"},
+		{in: "WezTerm 20240203-110809-5046fc22", name: "WezTerm", version: "20240203-110809-5046fc22"},
+		{in: "mlterm", name: "mlterm", version: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			name, version := splitTerminalVersion(tt.in)
+			if name != tt.name || version != tt.version {
+				t.Errorf("splitTerminalVersion() = %q, %q, want %q, %q", name, version, tt.name, tt.version)
+			}
+		})
+	}
+}
+
+func TestDecoder_QueryTerminal(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	replies := []string{
+		"\x1bP>|kitty(0.35.2)\x1b\\",
+		"\x1b[>1;4000;35c",
+		"\x1b[?0u",
+		"x",
+		"\x1b[?2026;2$y\x1b[?2004;2$y\x1b[?1004;2$y\x1b[?1006;0$y",
+		"\x1b[?62;4;52c",
+	}
+	go w.Write([]byte(strings.Join(replies, "")))
+
+	var out bytes.Buffer
+	got, err := d.QueryTerminal(&out, time.Second)
+	if err != nil {
+		t.Fatalf("Decoder.QueryTerminal() error = %v", err)
+	}
+	want := TerminalInfo{
+		Name: "kitty", Version: "0.35.2", Level: 2, Attributes: []int{4, 52}, Type: 1, Firmware: 4000,
+		Sixel: true, Clipboard: true, KittyKeyboard: true, SynchronizedOutput: true, BracketedPaste: true, FocusReporting: true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decoder.QueryTerminal() = %+v, want %+v", got, want)
+	}
+	if !strings.HasSuffix(out.String(), QUERY_PRIMARY_DEVICE_ATTRIBUTES) || !strings.Contains(out.String(), QueryPrivateMode(2026)) {
+		t.Errorf("Decoder.QueryTerminal() wrote %q", out.String())
+	}
+
+	// Key typed while waiting is kept
+	if ev, _ := d.ReadEvent(); ev != (KeyEvent{Key: KEY_RUNE, Rune: 'x'}) {
+		t.Errorf("ReadEvent() = %#v, want key", ev)
+	}
+}
+
+func TestDecoder_QueryTerminalTimeout(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	go w.Write([]byte("\x1bP>|foot(1.17.2)\x1b\\"))
+
+	got, err := d.QueryTerminal(io.Discard, 20*time.Millisecond)
+	if err != ErrNoReply {
+		t.Errorf("Decoder.QueryTerminal() error = %v, want %v", err, ErrNoReply)
+	}
+	if got.Name != "foot" {
+		t.Errorf("Decoder.QueryTerminal() name = %q, want %q", got.Name, "foot")
+	}
+}
+
+func TestDecoder_ReadPrivateMode(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	d := NewDecoder(r)
+	go w.Write([]byte("\x1b[?2004;1$y\x1b[?2026;4$y"))
+
+	got, err := d.ReadPrivateMode(io.Discard, 2026, time.Second)
+	if err != nil || got != MODE_PERMANENTLY_RESET {
+		t.Errorf("Decoder.ReadPrivateMode() = %v, %v, want %v, nil", got, err, MODE_PERMANENTLY_RESET)
+	}
+	if got.Supported() {
+		t.Error("ModeSetting.Supported() = true for MODE_PERMANENTLY_RESET")
+	}
+}
@@ -0,0 +1,229 @@
+package gonsole
+
+import (
+	"strings"
+)
+
+// Alignment is a position of text in padded field.
+type Alignment int
+
+const (
+	ALIGN_LEFT Alignment = iota
+	ALIGN_CENTER
+	ALIGN_RIGHT
+)
+
+// Get length of escape sequence at the start of s, which must start with ESC.
+// Handles CSI, OSC, DCS, SOS, PM and APC strings and short escape sequences. Unterminated sequence takes the rest of s.
+func escapeLength(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7E {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']', 'P', 'X', '^', '_':
+		for i := 2; i < len(s); i++ {
+			if s[i] == 0x07 && s[1] == ']' {
+				return i + 1
+			}
+			if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+
+	// Intermediate bytes followed by final byte, like ESC ( B
+	for i := 1; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x2F {
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// Get text without any escape sequences.
+func Strip(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+
+	var result strings.Builder
+	result.Grow(len(s))
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			i += escapeLength(s[i:])
+			continue
+		}
+		next := strings.IndexByte(s[i:], 0x1b)
+		if next < 0 {
+			next = len(s) - i
+		}
+		result.WriteString(s[i : i+next])
+		i += next
+	}
+	return result.String()
+}
+
+// Get number of terminal cells text takes. Escape sequences, control characters, combining marks
+// and zero width characters take no cells, East Asian wide characters and emoji take two cells.
+func Width(s string) int {
+	s = Strip(s)
+	width := 0
+	for len(s) > 0 {
+		size, w := nextCluster(s)
+		width += w
+		s = s[size:]
+	}
+	return width
+}
+
+// Get text cut to passed width with tail, like "…", at the end. Text that fits is returned as is,
+// and tail is dropped when it does not fit itself.
+// Escape sequences before the cut are kept, attributes left open are reset with DEFAULT and hyperlink left open is closed.
+func Truncate(s string, width int, tail string) string {
+	if Width(s) <= width {
+		return s
+	}
+
+	limit := width - Width(tail)
+	if limit < 0 {
+		limit, tail = width, ""
+	}
+
+	var result strings.Builder
+	var state SGRState
+	link := ""
+	current := 0
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			n := escapeLength(s[i:])
+			trackSGR(&state, s[i:i+n])
+			if url, ok := parseHyperlink(s[i : i+n]); ok {
+				link = url
+			}
+			result.WriteString(s[i : i+n])
+			i += n
+			continue
+		}
+
+		size, w := nextCluster(s[i:])
+		if current+w > limit {
+			break
+		}
+		current += w
+		result.WriteString(s[i : i+size])
+		i += size
+	}
+
+	result.WriteString(tail)
+	if link != "" {
+		result.WriteString(hyperlinkEnd)
+	}
+	if !state.IsDefault() {
+		result.WriteString(DEFAULT)
+	}
+	return result.String()
+}
+
+// Get text padded with spaces to passed width. Text wider than width is returned as is.
+// Attributes left open by text are reset with DEFAULT and hyperlink left open is closed, so padding is never styled.
+func Pad(s string, width int, align Alignment) string {
+	state, link := endState(s)
+	if link != "" {
+		s += hyperlinkEnd
+	}
+	if !state.IsDefault() {
+		s += DEFAULT
+	}
+
+	space := width - Width(s)
+	if space <= 0 {
+		return s
+	}
+
+	switch align {
+	case ALIGN_RIGHT:
+		return strings.Repeat(" ", space) + s
+	case ALIGN_CENTER:
+		return strings.Repeat(" ", space/2) + s + strings.Repeat(" ", space-space/2)
+	}
+	return s + strings.Repeat(" ", space)
+}
+
+// Apply escape sequence to SGR state when it is SGR sequence. Invalid parameters are ignored.
+func trackSGR(state *SGRState, seq string) {
+	if len(seq) >= 3 && seq[1] == '[' && seq[len(seq)-1] == 'm' {
+		state.Apply(seq[2 : len(seq)-1])
+	}
+}
+
+// Get SGR state and url of hyperlink left open at the end of text.
+func endState(s string) (SGRState, string) {
+	var state SGRState
+	link := ""
+	for i := 0; i < len(s); {
+		next := strings.IndexByte(s[i:], 0x1b)
+		if next < 0 {
+			break
+		}
+		i += next
+		n := escapeLength(s[i:])
+		trackSGR(&state, s[i:i+n])
+		if url, ok := parseHyperlink(s[i : i+n]); ok {
+			link = url
+		}
+		i += n
+	}
+	return state, link
+}
+
+// Part of text printed with the same attributes.
+type styledSegment struct {
+	text  string
+	state SGRState
+}
+
+// Split text into parts with the same attributes. Escape sequences other than SGR are dropped.
+func styledSegments(s string) []styledSegment {
+	var result []styledSegment
+	var state SGRState
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			result = append(result, styledSegment{text: text.String(), state: state})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		if s[i] != 0x1b {
+			next := strings.IndexByte(s[i:], 0x1b)
+			if next < 0 {
+				next = len(s) - i
+			}
+			text.WriteString(s[i : i+next])
+			i += next
+			continue
+		}
+
+		n := escapeLength(s[i:])
+		next := state
+		trackSGR(&next, s[i:i+n])
+		if next != state {
+			flush()
+			state = next
+		}
+		i += n
+	}
+	flush()
+
+	return result
+}
@@ -0,0 +1,115 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestStrip(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "plain", s: "text", want: "text"},
+		{name: "sgr", s: BOLD + COLOR_RED.Foreground() + "text" + DEFAULT, want: "text"},
+		{name: "csi", s: "\x1b[2J\x1b[?25lte\x1b[1;1Hxt", want: "text"},
+		{name: "osc bel", s: "\x1b]0;title\x07text", want: "text"},
+		{name: "osc st", s: "\x1b]8;;http://example.com\x1b\\text\x1b]8;;\x1b\\", want: "text"},
+		{name: "dcs", s: "\x1bP1$r0m\x1b\\text", want: "text"},
+		{name: "short", s: "\x1b(Btext\x1b7", want: "text"},
+		{name: "unterminated", s: "text\x1b[31", want: "text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Strip(tt.s); got != tt.want {
+				t.Errorf("Strip() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{name: "empty", s: "", want: 0},
+		{name: "ascii", s: "hello", want: 5},
+		{name: "styled", s: Style{}.Bold().Foreground(COLOR_RED).Render("hello"), want: 5},
+		{name: "cyrillic", s: "привет", want: 6},
+		{name: "cjk", s: "日本語", want: 6},
+		{name: "hangul", s: "한국어", want: 6},
+		{name: "fullwidth", s: "ＡＢ", want: 4},
+		{name: "combining", s: "éé", want: 2},
+		{name: "zero width", s: "a\u200bb\ufeff", want: 2},
+		{name: "control", s: "a\tb\n", want: 2},
+		{name: "emoji", s: "🙂", want: 2},
+		{name: "emoji zwj", s: "👩‍💻", want: 2},
+		{name: "family", s: "👨‍👩‍👧‍👦", want: 2},
+		{name: "skin tone", s: "👍🏽", want: 2},
+		{name: "flag", s: "🇺🇦", want: 2},
+		{name: "emoji presentation", s: "❤️", want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Width(tt.s); got != tt.want {
+				t.Errorf("Width() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		tail  string
+		want  string
+	}{
+		{name: "fits", s: "hello", width: 5, tail: "…", want: "hello"},
+		{name: "plain", s: "hello world", width: 6, tail: "…", want: "hello…"},
+		{name: "no tail", s: "hello world", width: 5, tail: "", want: "hello"},
+		{name: "styled", s: BOLD + "hello world" + DEFAULT, width: 6, tail: "…", want: BOLD + "hello…" + DEFAULT},
+		{name: "reset before cut", s: BOLD + "he" + DEFAULT + "llo world", width: 6, tail: "…", want: BOLD + "he" + DEFAULT + "llo…"},
+		{name: "wide", s: "日本語テキスト", width: 7, tail: "…", want: "日本語…"},
+		{name: "keeps cluster", s: "aébcdef", width: 4, tail: "…", want: "aéb…"},
+		{name: "tail too wide", s: "hello", width: 2, tail: "...", want: "he"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.s, tt.width, tt.tail); got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		align Alignment
+		want  string
+	}{
+		{name: "left", s: "ab", width: 5, align: ALIGN_LEFT, want: "ab   "},
+		{name: "right", s: "ab", width: 5, align: ALIGN_RIGHT, want: "   ab"},
+		{name: "center", s: "ab", width: 5, align: ALIGN_CENTER, want: " ab  "},
+		{name: "wide", s: "日本", width: 6, align: ALIGN_LEFT, want: "日本  "},
+		{name: "styled", s: COLOR_RED.Foreground() + "ab" + DEFAULT, width: 3, align: ALIGN_LEFT, want: COLOR_RED.Foreground() + "ab" + DEFAULT + " "},
+		{name: "open attributes", s: COLOR_RED.Background() + "ab", width: 3, align: ALIGN_RIGHT, want: " " + COLOR_RED.Background() + "ab" + DEFAULT},
+		{name: "too wide", s: "abcdef", width: 3, align: ALIGN_LEFT, want: "abcdef"},
+		{name: "open hyperlink", s: "\x1b]8;;http://x\x1b\\ab", width: 3, align: ALIGN_LEFT, want: "\x1b]8;;http://x\x1b\\ab" + hyperlinkEnd + " "},
+		{name: "open hyperlink styled", s: BOLD + "\x1b]8;;http://x\x1b\\ab", width: 3, align: ALIGN_RIGHT, want: " " + BOLD + "\x1b]8;;http://x\x1b\\ab" + hyperlinkEnd + DEFAULT},
+		{name: "closed hyperlink", s: Hyperlink("http://x", "ab"), width: 3, align: ALIGN_LEFT, want: Hyperlink("http://x", "ab") + " "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Pad(tt.s, tt.width, tt.align); got != tt.want {
+				t.Errorf("Pad() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package gonsole
+
+// Theme is a set of colors terminal uses to draw text: default colors and the first 16 palette colors,
+// which are usually redefined by terminal. Other palette colors are taken from COLOR_* constants.
+type Theme struct {
+	Foreground RGB     // Default foreground color
+	Background RGB     // Default background color
+	ANSI       [16]RGB // Colors from COLOR_BLACK to COLOR_WHITE
+}
+
+// Get theme with COLOR_SILVER text on COLOR_BLACK background and standard values of the first 16 colors.
+func DefaultTheme() Theme {
+	t := Theme{Foreground: COLOR_SILVER.RGB(), Background: COLOR_BLACK.RGB()}
+	for i := range t.ANSI {
+		t.ANSI[i] = PaletteColor(i).RGB()
+	}
+	return t
+}
+
+// Get RGB value of color in theme. Nil and DefaultColor are replaced with fallback.
+func (t Theme) RGB(c Color, fallback RGB) RGB {
+	if v, ok := c.(PaletteColor); ok && v >= 0 && v < 16 {
+		return t.ANSI[v]
+	}
+	return colorRGB(c, fallback)
+}
+
+// Get text to load theme into terminal: foreground, background and 16 standard colors.
+// Restore terminal colors with RESET_COLORS.
+func (t Theme) Sequence() string {
+	colors := make(map[PaletteColor]RGB, len(t.ANSI))
+	for i, c := range t.ANSI {
+		colors[PaletteColor(i)] = c
+	}
+	return SetColor(TERMINAL_FOREGROUND, t.Foreground) + SetColor(TERMINAL_BACKGROUND, t.Background) + SetPalette(colors)
+}
+
+// Get foreground and background RGB values of text with attributes, handling inverted text.
+func (t Theme) colors(state SGRState) (RGB, RGB) {
+	fg, bg := t.RGB(state.Foreground, t.Foreground), t.RGB(state.Background, t.Background)
+	if state.Inverted {
+		return bg, fg
+	}
+	return fg, bg
+}
@@ -0,0 +1,68 @@
+package gonsole
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	PUSH_TITLE = "\x1b[22;0t" // Save window title and icon name on terminal stack (XTWINOPS)
+	POP_TITLE  = "\x1b[23;0t" // Restore window title and icon name saved with PUSH_TITLE
+)
+
+// Get text to set window title (OSC 2).
+func SetTitle(title string) string {
+	return osc("2;" + oscText(title))
+}
+
+// Get text to set icon name (OSC 1), shown by some terminals in tab title.
+func SetIconName(name string) string {
+	return osc("1;" + oscText(name))
+}
+
+// Get text to set both window title and icon name (OSC 0).
+func SetTitleAndIconName(title string) string {
+	return osc("0;" + oscText(title))
+}
+
+// Get text to report current working directory to terminal (OSC 7), so new tabs open in it.
+// Relative path is resolved against process working directory.
+func SetWorkingDirectory(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	host, _ := os.Hostname()
+	return osc("7;" + (&url.URL{Scheme: "file", Host: host, Path: path}).String())
+}
+
+// Get text to show desktop notification with message (OSC 9), supported by iTerm2, kitty, WezTerm and others.
+func Notify(message string) string {
+	return osc("9;" + oscText(message))
+}
+
+// Get text to show desktop notification with title and body (OSC 777), supported by urxvt, foot, Ghostty and others.
+// Semicolons are removed from title, because they separate fields.
+func NotifyWithTitle(title, body string) string {
+	return osc("777;notify;" + strings.ReplaceAll(oscText(title), ";", "") + ";" + oscText(body))
+}
+
+// Get OSC sequence with passed content, terminated with ST.
+func osc(content string) string {
+	return "\x1b]" + content + "\x1b\\"
+}
+
+// Get text without control characters, which would end OSC sequence.
+func oscText(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r >= 0x7F && r < 0xA0 {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,47 @@
+package gonsole
+
+import (
+	"os"
+	"testing"
+)
+
+func TestWindowSequences(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "title", got: SetTitle("build: 50%"), want: "\x1b]2;build: 50%\x1b\\"},
+		{name: "title controls removed", got: SetTitle("a\x1b]0;b\x07\u009cc"), want: "\x1b]2;a]0;bc\x1b\\"},
+		{name: "icon name", got: SetIconName("job"), want: "\x1b]1;job\x1b\\"},
+		{name: "title and icon name", got: SetTitleAndIconName("задача"), want: "\x1b]0;задача\x1b\\"},
+		{name: "notify", got: Notify("Build finished"), want: "\x1b]9;Build finished\x1b\\"},
+		{name: "notify with title", got: NotifyWithTitle("CI; main", "3 passed; 1 failed"), want: "\x1b]777;notify;CI main;3 passed; 1 failed\x1b\\"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetWorkingDirectory(t *testing.T) {
+	host, _ := os.Hostname()
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "absolute", path: "/home/user/my project", want: "\x1b]7;file://" + host + "/home/user/my%20project\x1b\\"},
+		{name: "cleaned", path: "/tmp/a/../b%", want: "\x1b]7;file://" + host + "/tmp/b%25\x1b\\"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetWorkingDirectory(tt.path); got != tt.want {
+				t.Errorf("SetWorkingDirectory() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package gonsole
+
+import (
+	"strings"
+)
+
+// WrapOptions configures wrapping of styled text.
+type WrapOptions struct {
+	Width         int    // Maximal width of line, including indent
+	Indent        string // Prefix of the first line of every paragraph
+	HangingIndent string // Prefix of continuation lines
+}
+
+// Get text wrapped to passed width on word boundaries.
+// Words longer than width are broken, active attributes and hyperlink are closed at the end of every line
+// and applied again on the next one.
+func Wrap(s string, width int) string {
+	return WrapOptions{Width: width}.Wrap(s)
+}
+
+// Get text wrapped on word boundaries with options.
+// Words longer than width are broken, active attributes and hyperlink are closed at the end of every line
+// and applied again on the next one.
+func (o WrapOptions) Wrap(s string) string {
+	w := wrapper{options: o}
+	for i, paragraph := range strings.Split(s, "\n") {
+		if i > 0 {
+			w.endLine()
+		}
+		w.paragraph(paragraph)
+	}
+	return w.out.String()
+}
+
+// State of wrapping.
+type wrapper struct {
+	options WrapOptions
+	out     strings.Builder
+	state   SGRState
+	link    string // Sequence opening hyperlink active at the end of current line
+	width   int    // Width of current line
+	empty   bool   // No visible text on current line yet
+}
+
+// Wrap one paragraph, which has no line breaks.
+func (w *wrapper) paragraph(s string) {
+	w.startLine(w.options.Indent)
+
+	pending := ""
+	for len(s) > 0 {
+		n := wordLength(s)
+		token := s[:n]
+		s = s[n:]
+
+		if token[0] == ' ' {
+			// Leading spaces of paragraph are kept, spaces at line breaks are dropped
+			pending += token
+			continue
+		}
+
+		tokenWidth := Width(token)
+		if !w.empty && w.width+Width(pending)+tokenWidth > w.options.Width {
+			w.endLine()
+			w.startLine(w.options.HangingIndent)
+		} else {
+			w.out.WriteString(pending)
+			w.width += Width(pending)
+		}
+		pending = ""
+
+		w.word(token, w.width+tokenWidth > w.options.Width)
+	}
+	w.out.WriteString(pending)
+}
+
+// Write word, breaking it when it does not fit into line.
+func (w *wrapper) word(s string, hard bool) {
+	for len(s) > 0 {
+		if s[0] == 0x1b {
+			n := escapeLength(s)
+			trackSGR(&w.state, s[:n])
+			if url, ok := parseHyperlink(s[:n]); ok {
+				w.link = ""
+				if url != "" {
+					w.link = s[:n]
+				}
+			}
+			w.out.WriteString(s[:n])
+			s = s[n:]
+			continue
+		}
+
+		size, width := nextCluster(s)
+		if hard && !w.empty && w.width+width > w.options.Width {
+			w.endLine()
+			w.startLine(w.options.HangingIndent)
+		}
+		w.out.WriteString(s[:size])
+		w.width += width
+		w.empty = w.empty && width == 0
+		s = s[size:]
+	}
+}
+
+// Start new line with prefix, active attributes and hyperlink.
+func (w *wrapper) startLine(prefix string) {
+	w.out.WriteString(prefix)
+	w.out.WriteString(w.state.Sequence())
+	w.out.WriteString(w.link)
+	w.width = Width(prefix)
+	w.empty = true
+}
+
+// End current line, closing hyperlink and resetting active attributes.
+func (w *wrapper) endLine() {
+	if w.link != "" {
+		w.out.WriteString(hyperlinkEnd)
+	}
+	if !w.state.IsDefault() {
+		w.out.WriteString(DEFAULT)
+	}
+	w.out.WriteByte('\n')
+}
+
+// Get length of the first token of text: either run of spaces or word with escape sequences in it.
+func wordLength(s string) int {
+	if s[0] == ' ' {
+		return len(s) - len(strings.TrimLeft(s, " "))
+	}
+
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case ' ':
+			return i
+		case 0x1b:
+			i += escapeLength(s[i:])
+		default:
+			i++
+		}
+	}
+	return len(s)
+}
@@ -0,0 +1,55 @@
+package gonsole
+
+import (
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	red := COLOR_RED.Foreground()
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{name: "fits", s: "hello world", width: 20, want: "hello world"},
+		{name: "words", s: "the quick brown fox jumps", width: 10, want: "the quick\nbrown fox\njumps"},
+		{name: "exact", s: "aaaa bbbb", width: 4, want: "aaaa\nbbbb"},
+		{name: "hard break", s: "abcdefghij xy", width: 4, want: "abcd\nefgh\nij\nxy"},
+		{name: "existing newlines", s: "ab cd\nef", width: 2, want: "ab\ncd\nef"},
+		{name: "leading spaces", s: "  ab cd", width: 5, want: "  ab\ncd"},
+		{name: "wide", s: "日本語 テキスト", width: 6, want: "日本語\nテキス\nト"},
+		{name: "style reapplied", s: red + "aa bb" + DEFAULT + " cc", width: 2, want: red + "aa" + DEFAULT + "\n" + red + "bb" + DEFAULT + "\ncc"},
+		{name: "style across newline", s: BOLD + "aa\nbb" + DEFAULT, width: 10, want: BOLD + "aa" + DEFAULT + "\n" + BOLD + "bb" + DEFAULT},
+		{name: "style in hard break", s: "ab" + red + "cd", width: 3, want: "ab" + red + "c" + DEFAULT + "\n" + red + "d"},
+		{name: "hyperlink reopened", s: Hyperlink("http://x", "aaa bbb"), width: 3, want: "\x1b]8;;http://x\x1b\\aaa" + hyperlinkEnd + "\n\x1b]8;;http://x\x1b\\bbb" + hyperlinkEnd},
+		{name: "hyperlink with id", s: HyperlinkWithID("http://x", "ab", "1") + " cd", width: 1, want: "\x1b]8;id=1;http://x\x1b\\a" + hyperlinkEnd + "\n\x1b]8;id=1;http://x\x1b\\b" + hyperlinkEnd + "\nc\nd"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.s, tt.width); got != tt.want {
+				t.Errorf("Wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapOptions_Wrap(t *testing.T) {
+	tests := []struct {
+		name    string
+		options WrapOptions
+		s       string
+		want    string
+	}{
+		{name: "indent", options: WrapOptions{Width: 8, Indent: "  ", HangingIndent: "  "}, s: "aaa bbb ccc", want: "  aaa\n  bbb\n  ccc"},
+		{name: "hanging", options: WrapOptions{Width: 9, HangingIndent: "    "}, s: "usage: aaa bbb ccc", want: "usage:\n    aaa\n    bbb\n    ccc"},
+		{name: "prefix not styled", options: WrapOptions{Width: 5, HangingIndent: "> "}, s: BOLD + "aaa bbb", want: BOLD + "aaa" + DEFAULT + "\n> " + BOLD + "bbb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.Wrap(tt.s); got != tt.want {
+				t.Errorf("WrapOptions.Wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
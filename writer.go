@@ -0,0 +1,292 @@
+package gonsole
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Maximal length of escape sequence kept between writes. Longer sequences are written as is.
+const maxPendingSequence = 4096
+
+// Writer rewrites SGR sequences passing through it to match target color profile.
+// Truecolor colors are downsampled to the nearest palette color, 256 colors are downsampled to 16 standard colors,
+// and with PROFILE_NO_COLOR all SGR sequences are stripped. Other escape sequences are written as is.
+type Writer struct {
+	// Whether hyperlinks are supported, enabled by NewWriter for all profiles except PROFILE_NO_COLOR.
+	// Unsupported hyperlinks are written as text followed by url in parentheses.
+	Hyperlinks bool
+
+	w        io.Writer
+	profile  Profile
+	state    writerState
+	pending  []byte
+	link     string // Url of open hyperlink, when hyperlinks are not supported
+	linkText []byte
+}
+
+// State of escape sequence parser, escape sequence can be split across several writes.
+type writerState int
+
+const (
+	writerText writerState = iota
+	writerEscape
+	writerCSI
+	writerString       // OSC, DCS, SOS, PM or APC string
+	writerStringEscape // ESC in string, may start string terminator
+)
+
+// Create writer rewriting escape sequences for passed color profile.
+func NewWriter(w io.Writer, profile Profile) *Writer {
+	return &Writer{w: w, profile: profile, Hyperlinks: profile != PROFILE_NO_COLOR}
+}
+
+// Write text, rewriting complete escape sequences. Incomplete sequence at the end of p is kept until next write.
+// When underlying writer fails, returned count is the number of bytes of p whose output was fully written.
+func (w *Writer) Write(p []byte) (int, error) {
+	saved := w.save()
+	var out bytes.Buffer
+	out.Grow(len(p))
+	for _, b := range p {
+		w.writeByte(b, &out)
+	}
+	if out.Len() == 0 {
+		return len(p), nil
+	}
+
+	n, err := w.w.Write(out.Bytes())
+	if err == nil {
+		return len(p), nil
+	}
+
+	// Parse p again up to written output, so bytes written again after error are not processed twice
+	w.restore(saved)
+	out.Reset()
+	consumed := 0
+	for _, b := range p {
+		before := w.save()
+		w.writeByte(b, &out)
+		if out.Len() > n {
+			w.restore(before)
+			break
+		}
+		consumed++
+	}
+	return consumed, err
+}
+
+// Parse next byte of text, writing text and complete escape sequences to out.
+func (w *Writer) writeByte(b byte, out *bytes.Buffer) {
+	switch w.state {
+	case writerText:
+		if b == 0x1b {
+			w.pending = append(w.pending[:0], b)
+			w.state = writerEscape
+		} else {
+			out.WriteByte(b)
+			if w.link != "" && len(w.linkText) <= len(w.link) {
+				w.linkText = append(w.linkText, b)
+			}
+		}
+	case writerEscape:
+		w.pending = append(w.pending, b)
+		switch b {
+		case '[':
+			w.state = writerCSI
+		case ']', 'P', 'X', '^', '_':
+			w.state = writerString
+		default:
+			w.flushPending(out)
+		}
+	case writerCSI:
+		w.pending = append(w.pending, b)
+		switch {
+		case b >= 0x40 && b <= 0x7E:
+			w.writeCSI(out)
+		case b < 0x20 || b > 0x3F:
+			w.flushPending(out)
+		}
+	case writerString:
+		w.pending = append(w.pending, b)
+		switch {
+		case b == 0x07 && w.pending[1] == ']':
+			// BEL ends OSC only, other strings end with ST
+			w.writeString(out)
+		case b == 0x1b:
+			w.state = writerStringEscape
+		}
+	case writerStringEscape:
+		w.pending = append(w.pending, b)
+		if b == '\\' {
+			w.writeString(out)
+		} else {
+			w.state = writerString
+		}
+	}
+
+	if len(w.pending) > maxPendingSequence {
+		w.flushPending(out)
+	}
+}
+
+// Copy of parser state, to restore it when output fails.
+type writerSnapshot struct {
+	state    writerState
+	pending  []byte
+	link     string
+	linkText []byte
+}
+
+// Save parser state.
+func (w *Writer) save() writerSnapshot {
+	return writerSnapshot{
+		state:    w.state,
+		pending:  append([]byte(nil), w.pending...),
+		link:     w.link,
+		linkText: append([]byte(nil), w.linkText...),
+	}
+}
+
+// Restore parser state saved before.
+func (w *Writer) restore(s writerSnapshot) {
+	w.state = s.state
+	w.pending = append(w.pending[:0], s.pending...)
+	w.link = s.link
+	w.linkText = append(w.linkText[:0], s.linkText...)
+}
+
+// Write incomplete escape sequence kept from previous writes as is.
+func (w *Writer) Flush() error {
+	if w.state == writerText {
+		return nil
+	}
+
+	var out bytes.Buffer
+	w.flushPending(&out)
+	_, err := w.w.Write(out.Bytes())
+	return err
+}
+
+// Write pending bytes as is and return to text state.
+func (w *Writer) flushPending(out *bytes.Buffer) {
+	out.Write(w.pending)
+	w.pending = w.pending[:0]
+	w.state = writerText
+}
+
+// Write complete CSI sequence, rewriting it when it is SGR.
+func (w *Writer) writeCSI(out *bytes.Buffer) {
+	seq := string(w.pending)
+	w.pending = w.pending[:0]
+	w.state = writerText
+
+	params := seq[2 : len(seq)-1]
+	if seq[len(seq)-1] != 'm' || strings.Trim(params, "0123456789;:") != "" {
+		out.WriteString(seq)
+		return
+	}
+	if w.profile == PROFILE_NO_COLOR {
+		return
+	}
+	if w.profile == PROFILE_TRUECOLOR || params == "" {
+		out.WriteString(seq)
+		return
+	}
+
+	if params = rewriteSGR(params, w.profile); params != "" {
+		out.WriteString("\x1b[" + params + "m")
+	}
+}
+
+// Write complete string sequence, replacing hyperlink with url after text when hyperlinks are not supported.
+func (w *Writer) writeString(out *bytes.Buffer) {
+	url, ok := parseHyperlink(string(w.pending))
+	if w.Hyperlinks || !ok {
+		w.flushPending(out)
+		return
+	}
+	w.pending = w.pending[:0]
+	w.state = writerText
+
+	// Url is not repeated when text is url itself
+	if w.link != "" && string(w.linkText) != w.link {
+		out.WriteString(" (" + w.link + ")")
+	}
+	w.link = url
+	w.linkText = w.linkText[:0]
+}
+
+// Rewrite colors in SGR parameters to match profile. Returns empty string when nothing is left.
+func rewriteSGR(params string, profile Profile) string {
+	fields := strings.Split(params, ";")
+	result := make([]string, 0, len(fields))
+
+	for i := 0; i < len(fields); i++ {
+		code, sub := fields[i], []string(nil)
+		if strings.Contains(code, ":") {
+			sub = strings.Split(code, ":")
+			code = sub[0]
+		}
+		if code != "38" && code != "48" && code != "58" {
+			result = append(result, fields[i])
+			continue
+		}
+
+		var c Color
+		start := i
+		if sub != nil {
+			c, _ = sgrColor(sub[1:], true)
+		} else {
+			var used int
+			c, used, _ = sgrColorFields(fields[i+1:])
+			i += used
+		}
+		if c == nil {
+			result = append(result, fields[start:i+1]...)
+			continue
+		}
+
+		base, _ := strconv.Atoi(code)
+		if p := colorParams(profile.Convert(c), base, profile); p != "" {
+			result = append(result, p)
+		}
+	}
+
+	return strings.Join(result, ";")
+}
+
+// Get SGR parameters setting color, where base is 38 for foreground, 48 for background and 58 for underline.
+// With PROFILE_ANSI standard colors are written as 30-37 and 90-97 codes. Returns empty string when color can not be shown.
+func colorParams(c Color, base int, profile Profile) string {
+	switch v := c.(type) {
+	case nil:
+		return ""
+	case DefaultColor:
+		return strconv.Itoa(base + 1)
+	case PaletteColor:
+		if profile == PROFILE_ANSI {
+			if base == 58 {
+				return ""
+			}
+			if v < 8 {
+				return strconv.Itoa(base - 8 + int(v))
+			}
+			return strconv.Itoa(base + 52 + int(v) - 8)
+		}
+		return strconv.Itoa(base) + ";5;" + strconv.Itoa(int(v))
+	case RGB:
+		return strconv.Itoa(base) + ";2;" + strconv.Itoa(int(v.R)) + ";" + strconv.Itoa(int(v.G)) + ";" + strconv.Itoa(int(v.B))
+	}
+
+	var seq string
+	switch base {
+	case 38:
+		seq = c.Foreground()
+	case 48:
+		seq = c.Background()
+	default:
+		seq = c.Underline()
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(seq, "\x1b["), "m")
+}
@@ -0,0 +1,148 @@
+package gonsole
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestWriter_Write(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile Profile
+		input   string
+		want    string
+	}{
+		{name: "truecolor keeps", profile: PROFILE_TRUECOLOR, input: "\x1b[38;2;95;135;255mtext" + DEFAULT, want: "\x1b[38;2;95;135;255mtext" + DEFAULT},
+		{name: "truecolor to 256", profile: PROFILE_ANSI256, input: "\x1b[38;2;95;135;255mtext", want: "\x1b[38;5;69mtext"},
+		{name: "colon truecolor to 256", profile: PROFILE_ANSI256, input: "\x1b[1;48:2::95:135:255mtext", want: "\x1b[1;48;5;69mtext"},
+		{name: "256 keeps", profile: PROFILE_ANSI256, input: COLOR_RED.Foreground() + BOLD, want: COLOR_RED.Foreground() + BOLD},
+		{name: "256 to 16", profile: PROFILE_ANSI, input: Foreground(PaletteColor(196)) + "a" + Background(COLOR_MAROON) + "b", want: "\x1b[91ma\x1b[41mb"},
+		{name: "truecolor to 16", profile: PROFILE_ANSI, input: "\x1b[1;38;2;0;0;10;4m", want: "\x1b[1;30;4m"},
+		{name: "underline color dropped in 16", profile: PROFILE_ANSI, input: "\x1b[58;5;100m" + UNDERLINED, want: UNDERLINED},
+		{name: "invalid color kept", profile: PROFILE_ANSI, input: "\x1b[38;5;300m", want: "\x1b[38;5;300m"},
+		{name: "reset kept", profile: PROFILE_ANSI, input: "\x1b[m" + DEFAULT, want: "\x1b[m" + DEFAULT},
+		{name: "no color strips", profile: PROFILE_NO_COLOR, input: BOLD + COLOR_RED.Foreground() + "text" + DEFAULT + "\x1b[m", want: "text"},
+		{name: "other csi kept", profile: PROFILE_NO_COLOR, input: "\x1b[2J\x1b[?25lx", want: "\x1b[2J\x1b[?25lx"},
+		{name: "osc kept", profile: PROFILE_NO_COLOR, input: "\x1b]0;title\x07\x1b]2;t\x1b\\x", want: "\x1b]0;title\x07\x1b]2;t\x1b\\x"},
+		{name: "broken csi", profile: PROFILE_NO_COLOR, input: "\x1b[31\nx", want: "\x1b[31\nx"},
+		{name: "dcs kept", profile: PROFILE_NO_COLOR, input: "\x1bPtmux;\x1b\x1b[31mx\x1b\\y", want: "\x1bPtmux;\x1b\x1b[31mx\x1b\\y"},
+		{name: "apc kept", profile: PROFILE_NO_COLOR, input: "\x1b_a\x07\x1b[1m\x1b\\y", want: "\x1b_a\x07\x1b[1m\x1b\\y"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := NewWriter(&out, tt.profile)
+			n, err := w.Write([]byte(tt.input))
+			if err != nil || n != len(tt.input) {
+				t.Errorf("Writer.Write() = %v, %v, want %v, nil", n, err, len(tt.input))
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("Writer.Write() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_WriteSplit(t *testing.T) {
+	input := "a\x1b[38;2;95;135;255mb\x1b]0;t\x1b\\c" + DEFAULT
+	want := "a\x1b[38;5;69mb\x1b]0;t\x1b\\c" + DEFAULT
+	for split := 0; split <= len(input); split++ {
+		var out bytes.Buffer
+		w := NewWriter(&out, PROFILE_ANSI256)
+		w.Write([]byte(input[:split]))
+		w.Write([]byte(input[split:]))
+		if got := out.String(); got != want {
+			t.Errorf("Writer.Write() split at %d wrote %q, want %q", split, got, want)
+		}
+	}
+}
+
+// Writer failing after limit of bytes is written.
+type limitedWriter struct {
+	bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n, _ := w.Buffer.Write(p[:w.limit])
+		w.limit = 0
+		return n, io.ErrShortWrite
+	}
+	w.limit -= len(p)
+	return w.Buffer.Write(p)
+}
+
+func TestWriter_WriteError(t *testing.T) {
+	input := "ab\x1b[38;2;95;135;255mcd"
+	out := &limitedWriter{limit: 2}
+	w := NewWriter(out, PROFILE_ANSI256)
+
+	n, err := w.Write([]byte(input))
+	if err != io.ErrShortWrite || n != len("ab\x1b[38;2;95;135;255") {
+		t.Errorf("Writer.Write() = %v, %v, want %v, %v", n, err, len("ab\x1b[38;2;95;135;255"), io.ErrShortWrite)
+	}
+
+	// Retried bytes are processed once
+	out.limit = 100
+	if _, err := w.Write([]byte(input[n:])); err != nil {
+		t.Fatalf("Writer.Write() error = %v", err)
+	}
+	if got, want := out.String(), "ab\x1b[38;5;69mcd"; got != want {
+		t.Errorf("Writer.Write() wrote %q, want %q", got, want)
+	}
+}
+
+func TestWriter_Flush(t *testing.T) {
+	var out bytes.Buffer
+	w := NewWriter(&out, PROFILE_NO_COLOR)
+	w.Write([]byte("text\x1b[3"))
+	if got := out.String(); got != "text" {
+		t.Errorf("Writer.Write() wrote %q, want %q", got, "text")
+	}
+	if err := w.Flush(); err != nil {
+		t.Errorf("Writer.Flush() error = %v", err)
+	}
+	if got := out.String(); got != "text\x1b[3" {
+		t.Errorf("Writer.Flush() wrote %q, want %q", got, "text\x1b[3")
+	}
+}
+
+func TestWriter_Hyperlinks(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    Profile
+		hyperlinks bool
+		input      string
+		want       string
+	}{
+		{name: "supported", profile: PROFILE_ANSI, hyperlinks: true, input: Hyperlink("http://x", "site"), want: Hyperlink("http://x", "site")},
+		{name: "fallback", profile: PROFILE_ANSI, input: "see " + Hyperlink("http://x", "site") + "!", want: "see site (http://x)!"},
+		{name: "fallback styled", profile: PROFILE_ANSI, input: Hyperlink("http://x", BOLD+"site"+DEFAULT), want: BOLD + "site" + DEFAULT + " (http://x)"},
+		{name: "fallback url text", profile: PROFILE_ANSI, input: Hyperlink("http://x", "http://x"), want: "http://x"},
+		{name: "fallback bell", profile: PROFILE_ANSI, input: "\x1b]8;id=1;http://x\x07a\x1b]8;;\x07", want: "a (http://x)"},
+		{name: "fallback reopened", profile: PROFILE_ANSI, input: "\x1b]8;;http://a\x1b\\a\x1b]8;;http://b\x1b\\b" + hyperlinkEnd, want: "a (http://a)b (http://b)"},
+		{name: "other osc", profile: PROFILE_ANSI, input: "\x1b]0;t\x07", want: "\x1b]0;t\x07"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := NewWriter(&out, tt.profile)
+			w.Hyperlinks = tt.hyperlinks
+			w.Write([]byte(tt.input))
+			if got := out.String(); got != tt.want {
+				t.Errorf("Writer.Write() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWriter_Hyperlinks(t *testing.T) {
+	if w := NewWriter(nil, PROFILE_NO_COLOR); w.Hyperlinks {
+		t.Error("NewWriter() enabled hyperlinks for PROFILE_NO_COLOR")
+	}
+	if w := NewWriter(nil, PROFILE_ANSI); !w.Hyperlinks {
+		t.Error("NewWriter() disabled hyperlinks for PROFILE_ANSI")
+	}
+}